@@ -0,0 +1,78 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+)
+
+// GraphClients bundles the stable and beta Microsoft Graph SDK clients the
+// provider configures once in Configure and then hands to every resource and
+// data source as ProviderData/ResourceData. The HTTP client, credential, and
+// service roots are also retained so ad-hoc callers (e.g. the
+// microsoft365_graph_query/graph_beta_query data sources) can issue requests
+// the generated SDKs don't have a typed method for.
+type GraphClients struct {
+	StableClient *msgraphsdk.GraphServiceClient
+	BetaClient   *msgraphbetasdk.GraphServiceClient
+
+	// HTTPClient is the same *http.Client the Kiota adapters were built
+	// with, including the configured proxy and retry transport.
+	HTTPClient *http.Client
+
+	// Credential is the azidentity credential obtained via obtainCredential,
+	// used to mint bearer tokens for requests that bypass the SDK adapters.
+	Credential azcore.TokenCredential
+
+	// APIScope is the OAuth scope requested when minting tokens via
+	// Credential, e.g. "https://graph.microsoft.com/.default".
+	APIScope string
+
+	GraphServiceRoot     string
+	GraphBetaServiceRoot string
+
+	// AuthorityURL is the Entra ID authority host credentials were obtained
+	// from, e.g. "https://login.microsoftonline.com/".
+	AuthorityURL string
+
+	// Cloud is the resolved `cloud` provider attribute, e.g. "public" or
+	// "custom".
+	Cloud string
+
+	// AuthMethod is the resolved `auth_method` provider attribute.
+	AuthMethod string
+
+	// TenantID and ClientID are the resolved Entra ID application
+	// identifiers. Neither is a secret, unlike ClientSecret/ClientCertificate,
+	// so both are safe to surface to data sources such as
+	// microsoft365_provider_config.
+	TenantID string
+	ClientID string
+
+	// UseProxy reports whether the provider is routing requests through the
+	// configured HTTP proxy.
+	UseProxy bool
+
+	// ProviderVersion is the provider version string passed to provider.New.
+	ProviderVersion string
+
+	// BatchRequests reports whether the provider's batch_requests attribute
+	// is enabled.
+	BatchRequests bool
+
+	// StableBatch and BetaBatch coalesce requests against their respective
+	// adapters into POST /$batch calls. Both are nil unless BatchRequests is
+	// true; resources must check for nil before using them and fall back to
+	// calling the generated SDK methods directly.
+	StableBatch *BatchExecutor
+	BetaBatch   *BatchExecutor
+
+	// ConditionalAccessWhatIf reports whether the provider's
+	// conditional_access_whatif attribute is enabled, allowing the
+	// conditional access policy evaluation data source to run its dry-run
+	// evaluation against the /identity/conditionalAccess/evaluate preview
+	// endpoint.
+	ConditionalAccessWhatIf bool
+}
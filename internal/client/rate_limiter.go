@@ -0,0 +1,101 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// scopeRateLimiter is a token bucket keyed by Graph permission scope (e.g.
+// "DeviceManagementConfiguration.ReadWrite.All"). BatchExecutor consults it
+// before sending a batch and replenishes/drains it from observed 429
+// responses, so a throttled scope backs off globally across every resource
+// sharing that scope instead of each resource instance discovering the
+// 429 independently.
+type scopeRateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	// burst is the bucket size and refillPerSecond the steady-state token
+	// budget new buckets are created with.
+	burst           float64
+	refillPerSecond float64
+}
+
+type tokenBucket struct {
+	tokens      float64
+	lastRefill  time.Time
+	pausedUntil time.Time
+}
+
+func newScopeRateLimiter(burst, refillPerSecond float64) *scopeRateLimiter {
+	return &scopeRateLimiter{
+		buckets:         make(map[string]*tokenBucket),
+		burst:           burst,
+		refillPerSecond: refillPerSecond,
+	}
+}
+
+// wait blocks until scope has at least one token available or a prior 429
+// backoff for scope has elapsed, whichever is later.
+func (l *scopeRateLimiter) wait(scope string) {
+	for {
+		d := l.reserve(scope)
+		if d <= 0 {
+			return
+		}
+		time.Sleep(d)
+	}
+}
+
+// reserve returns how long the caller must wait before scope has a token
+// available, consuming one token if it's immediately available.
+func (l *scopeRateLimiter) reserve(scope string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket, ok := l.buckets[scope]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, lastRefill: time.Now()}
+		l.buckets[scope] = bucket
+	}
+
+	if wait := time.Until(bucket.pausedUntil); wait > 0 {
+		return wait
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(bucket.lastRefill).Seconds()
+	bucket.tokens = minFloat(l.burst, bucket.tokens+elapsed*l.refillPerSecond)
+	bucket.lastRefill = now
+
+	if bucket.tokens < 1 {
+		return time.Duration((1 - bucket.tokens) / l.refillPerSecond * float64(time.Second))
+	}
+
+	bucket.tokens--
+	return 0
+}
+
+// backoff pauses scope entirely for retryAfter, in response to a 429
+// observed on any subresponse using that scope.
+func (l *scopeRateLimiter) backoff(scope string, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket, ok := l.buckets[scope]
+	if !ok {
+		bucket = &tokenBucket{lastRefill: time.Now()}
+		l.buckets[scope] = bucket
+	}
+
+	until := time.Now().Add(retryAfter)
+	if until.After(bucket.pausedUntil) {
+		bucket.pausedUntil = until
+	}
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultRetryOnStatus is applied when the provider configuration does not
+// override `retry_on_status`. 429 (throttled) and the common transient 5xx
+// codes are the ones Intune tenants hit most often under load.
+var defaultRetryOnStatus = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusGatewayTimeout}
+
+// RetryTransportOptions configures RetryTransport. Zero values fall back to
+// sensible defaults via NewRetryTransport.
+type RetryTransportOptions struct {
+	// MaxRetries is the maximum number of retry attempts after the initial
+	// request.
+	MaxRetries int
+
+	// MaxWait caps the total backoff delay waited for a single retry,
+	// regardless of a Retry-After header value.
+	MaxWait time.Duration
+
+	// RetryOnStatus lists the HTTP status codes that trigger a retry, in
+	// addition to honoring any Retry-After header present on the response.
+	RetryOnStatus []int
+}
+
+// retryTransport wraps an http.RoundTripper (the base of the Kiota HTTP
+// pipeline) with exponential backoff honoring Graph's Retry-After header,
+// jittered retries for transient 5xx responses, and logs one tflog line per
+// retry carrying Graph's request-id so throttling can be correlated with
+// service-side telemetry.
+type retryTransport struct {
+	base    http.RoundTripper
+	options RetryTransportOptions
+}
+
+// NewRetryTransport returns an http.RoundTripper that retries requests
+// rejected by Microsoft Graph with 429/5xx responses, honoring Retry-After
+// and falling back to jittered exponential backoff. base is typically the
+// Transport of the *http.Client handed to the Kiota adapter constructors.
+func NewRetryTransport(base http.RoundTripper, options RetryTransportOptions) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if options.MaxRetries <= 0 {
+		options.MaxRetries = 3
+	}
+	if options.MaxWait <= 0 {
+		options.MaxWait = 30 * time.Second
+	}
+	if len(options.RetryOnStatus) == 0 {
+		options.RetryOnStatus = defaultRetryOnStatus
+	}
+	return &retryTransport{base: base, options: options}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		resp, err = t.base.RoundTrip(req)
+		if err != nil || !t.shouldRetry(resp) || attempt >= t.options.MaxRetries {
+			return resp, err
+		}
+
+		wait := t.backoff(resp, attempt)
+
+		tflog.Debug(ctx, "Retrying Microsoft Graph request after throttling/transient error", map[string]interface{}{
+			"attempt":     attempt + 1,
+			"max_retries": t.options.MaxRetries,
+			"status_code": resp.StatusCode,
+			"wait":        wait.String(),
+			"request_id":  resp.Header.Get("request-id"),
+		})
+
+		if resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if newReq, cloneErr := cloneRequest(req); cloneErr == nil {
+			req = newReq
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+	for _, code := range t.options.RetryOnStatus {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *retryTransport) backoff(resp *http.Response, attempt int) time.Duration {
+	if wait, ok := retryAfterDuration(resp); ok {
+		if wait > t.options.MaxWait {
+			return t.options.MaxWait
+		}
+		return wait
+	}
+
+	base := time.Duration(1<<uint(attempt)) * time.Second
+	jitter := time.Duration(rand.Int63n(int64(base) + 1))
+	wait := base + jitter
+	if wait > t.options.MaxWait {
+		wait = t.options.MaxWait
+	}
+	return wait
+}
+
+func retryAfterDuration(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/helpers"
+)
+
+// newDefaultAzureCredential builds the credential for `auth_method =
+// "default"`, dispatched to from obtainCredential. It delegates to
+// azidentity.DefaultAzureCredential, which walks environment variables,
+// workload identity, managed identity, and the Azure/Developer CLIs in
+// order until one succeeds.
+func newDefaultAzureCredential(clientOptions policy.ClientOptions) (*azidentity.DefaultAzureCredential, error) {
+	return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		ClientOptions: clientOptions,
+	})
+}
+
+// newManagedIdentityCredential builds the credential for `auth_method =
+// "managed_identity"`, dispatched to from obtainCredential. With
+// managed_identity_client_id unset it authenticates as the system-assigned
+// managed identity; otherwise it authenticates as the named user-assigned
+// identity, so the provider runs unattended on Azure VMs, Functions, and
+// Container Apps.
+func newManagedIdentityCredential(ctx context.Context, data M365ProviderModel, clientOptions policy.ClientOptions) (*azidentity.ManagedIdentityCredential, error) {
+	options := &azidentity.ManagedIdentityCredentialOptions{ClientOptions: clientOptions}
+
+	if clientID := helpers.GetValueOrEnv(ctx, data.ManagedIdentityClientID, "M365_MANAGED_IDENTITY_CLIENT_ID"); clientID != "" {
+		options.ID = azidentity.ClientID(clientID)
+	}
+
+	return azidentity.NewManagedIdentityCredential(options)
+}
+
+// newChainedCredential builds the credential for `auth_method = "chained"`.
+// It resolves each entry in the `chain` attribute to a credential via
+// resolveChainLink and combines them with azidentity.NewChainedTokenCredential,
+// which tries each in order and falls through to the next on failure.
+func newChainedCredential(ctx context.Context, data M365ProviderModel, clientOptions policy.ClientOptions) (*azidentity.ChainedTokenCredential, error) {
+	var links []string
+	if !data.Chain.IsNull() && !data.Chain.IsUnknown() {
+		data.Chain.ElementsAs(ctx, &links, false)
+	}
+	if len(links) == 0 {
+		return nil, fmt.Errorf("auth_method 'chained' requires at least one entry in the 'chain' attribute")
+	}
+
+	sources := make([]azcore.TokenCredential, 0, len(links))
+	for _, link := range links {
+		cred, err := resolveChainLink(ctx, link, data, clientOptions)
+		if err != nil {
+			return nil, fmt.Errorf("chain entry %q: %w", link, err)
+		}
+		sources = append(sources, cred)
+	}
+
+	return azidentity.NewChainedTokenCredential(sources, nil)
+}
+
+// resolveChainLink constructs the credential for a single entry of the
+// `chain` attribute, reusing whichever credential-specific attributes (e.g.
+// client_secret, managed_identity_client_id) are already set on the provider
+// block.
+func resolveChainLink(ctx context.Context, link string, data M365ProviderModel, clientOptions policy.ClientOptions) (azcore.TokenCredential, error) {
+	switch link {
+	case "environment":
+		return azidentity.NewEnvironmentCredential(&azidentity.EnvironmentCredentialOptions{ClientOptions: clientOptions})
+	case "managed_identity":
+		return newManagedIdentityCredential(ctx, data, clientOptions)
+	case "oidc", "workload_identity":
+		return newOIDCCredential(ctx, data, clientOptions)
+	case "azure_cli":
+		return azidentity.NewAzureCLICredential(nil)
+	case "client_secret":
+		tenantID := helpers.GetValueOrEnv(ctx, data.TenantID, "M365_TENANT_ID")
+		clientID := helpers.GetValueOrEnv(ctx, data.ClientID, "M365_CLIENT_ID")
+		clientSecret := helpers.GetValueOrEnv(ctx, data.ClientSecret, "M365_CLIENT_SECRET")
+		return azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: clientOptions,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported chain auth method %q", link)
+	}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/helpers"
+)
+
+// newInteractiveBrowserCredential builds the credential for `auth_method =
+// "interactive_browser"`, dispatched to from obtainCredential. When
+// token_cache is enabled it wires in azidentity's persistent cache and any
+// previously-persisted AuthenticationRecord, then eagerly authenticates and
+// persists the resulting record so subsequent runs reuse the signed-in
+// account instead of opening a browser again.
+func newInteractiveBrowserCredential(ctx context.Context, data M365ProviderModel, clientOptions policy.ClientOptions) (*azidentity.InteractiveBrowserCredential, error) {
+	persistentCache, record, err := tokenCacheOptions(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := helpers.GetValueOrEnv(ctx, data.TenantID, "M365_TENANT_ID")
+	clientID := helpers.GetValueOrEnv(ctx, data.ClientID, "M365_CLIENT_ID")
+	redirectURL := helpers.GetValueOrEnv(ctx, data.RedirectURL, "M365_REDIRECT_URL")
+
+	cred, err := azidentity.NewInteractiveBrowserCredential(&azidentity.InteractiveBrowserCredentialOptions{
+		ClientOptions:        clientOptions,
+		TenantID:             tenantID,
+		ClientID:             clientID,
+		RedirectURL:          redirectURL,
+		Cache:                persistentCache,
+		AuthenticationRecord: record,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := persistAuthenticationRecordIfEnabled(ctx, data, cred.Authenticate); err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
+
+// newDeviceCodeCredential builds the credential for `auth_method =
+// "device_code"`, dispatched to from obtainCredential. Token cache handling
+// mirrors newInteractiveBrowserCredential.
+func newDeviceCodeCredential(ctx context.Context, data M365ProviderModel, clientOptions policy.ClientOptions) (*azidentity.DeviceCodeCredential, error) {
+	persistentCache, record, err := tokenCacheOptions(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := helpers.GetValueOrEnv(ctx, data.TenantID, "M365_TENANT_ID")
+	clientID := helpers.GetValueOrEnv(ctx, data.ClientID, "M365_CLIENT_ID")
+
+	cred, err := azidentity.NewDeviceCodeCredential(&azidentity.DeviceCodeCredentialOptions{
+		ClientOptions:        clientOptions,
+		TenantID:             tenantID,
+		ClientID:             clientID,
+		Cache:                persistentCache,
+		AuthenticationRecord: record,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := persistAuthenticationRecordIfEnabled(ctx, data, cred.Authenticate); err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
+
+// newUsernamePasswordCredential builds the credential for `auth_method =
+// "username_password"`, dispatched to from obtainCredential. Token cache
+// handling mirrors newInteractiveBrowserCredential.
+func newUsernamePasswordCredential(ctx context.Context, data M365ProviderModel, clientOptions policy.ClientOptions) (*azidentity.UsernamePasswordCredential, error) {
+	persistentCache, record, err := tokenCacheOptions(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := helpers.GetValueOrEnv(ctx, data.TenantID, "M365_TENANT_ID")
+	clientID := helpers.GetValueOrEnv(ctx, data.ClientID, "M365_CLIENT_ID")
+	username := helpers.GetValueOrEnv(ctx, data.Username, "M365_USERNAME")
+	password := helpers.GetValueOrEnv(ctx, data.Password, "M365_PASSWORD")
+
+	cred, err := azidentity.NewUsernamePasswordCredential(tenantID, clientID, username, password, &azidentity.UsernamePasswordCredentialOptions{
+		ClientOptions:        clientOptions,
+		Cache:                persistentCache,
+		AuthenticationRecord: record,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := persistAuthenticationRecordIfEnabled(ctx, data, cred.Authenticate); err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/helpers"
+)
+
+// newOIDCCredential builds a credential for `auth_method = "oidc"` or
+// `"workload_identity"` from the provider configuration. It is dispatched to
+// from obtainCredential's auth_method switch alongside the other supported
+// methods.
+//
+// At least one assertion source must be configured; when several are, the
+// first one set in the order below is used and the rest are ignored:
+//   - oidc_token: a static JWT, read once.
+//   - oidc_token_file_path: a file re-read on every token request, for
+//     Kubernetes-projected service account tokens (AKS workload identity).
+//   - oidc_request_url + oidc_request_token: a token exchange endpoint
+//     queried on every token request, for GitHub Actions / GitLab CI / other
+//     OIDC-issuing CI providers.
+//
+// The assertion callback re-resolves the token on every call rather than
+// caching it, so long-running plans and applies do not fail when a
+// short-lived federated token expires mid-run.
+func newOIDCCredential(ctx context.Context, data M365ProviderModel, clientOptions policy.ClientOptions) (*azidentity.ClientAssertionCredential, error) {
+	tenantID := helpers.GetValueOrEnv(ctx, data.TenantID, "M365_TENANT_ID")
+	clientID := helpers.GetValueOrEnv(ctx, data.ClientID, "M365_CLIENT_ID")
+
+	assertion, err := oidcAssertionFunc(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return azidentity.NewClientAssertionCredential(tenantID, clientID, assertion, &azidentity.ClientAssertionCredentialOptions{
+		ClientOptions: clientOptions,
+	})
+}
+
+// oidcAssertionFunc resolves the configured assertion source into the
+// func(context.Context) (string, error) callback azidentity.
+// NewClientAssertionCredential calls on every token request.
+func oidcAssertionFunc(ctx context.Context, data M365ProviderModel) (func(context.Context) (string, error), error) {
+	if token := helpers.GetValueOrEnv(ctx, data.OidcToken, "M365_OIDC_TOKEN"); token != "" {
+		return func(context.Context) (string, error) {
+			return token, nil
+		}, nil
+	}
+
+	if path := helpers.GetValueOrEnv(ctx, data.OidcTokenFilePath, "M365_OIDC_TOKEN_FILE_PATH"); path != "" {
+		return func(context.Context) (string, error) {
+			token, err := os.ReadFile(path)
+			if err != nil {
+				return "", fmt.Errorf("failed to read oidc_token_file_path %q: %w", path, err)
+			}
+			return strings.TrimSpace(string(token)), nil
+		}, nil
+	}
+
+	requestURL := data.OidcRequestURL.ValueString()
+	if requestURL == "" {
+		requestURL = os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	}
+	requestToken := data.OidcRequestToken.ValueString()
+	if requestToken == "" {
+		requestToken = os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	}
+
+	if requestURL != "" && requestToken != "" {
+		return func(ctx context.Context) (string, error) {
+			return fetchOIDCToken(ctx, requestURL, requestToken)
+		}, nil
+	}
+
+	return nil, fmt.Errorf("auth_method 'oidc'/'workload_identity' requires one of oidc_token, " +
+		"oidc_token_file_path, or oidc_request_url/oidc_request_token (or their ACTIONS_ID_TOKEN_REQUEST_* " +
+		"environment variable equivalents) to be set")
+}
+
+// fetchOIDCToken requests an ID token from a CI provider's OIDC token
+// exchange endpoint, following the GitHub Actions `id-token: write`
+// convention (also compatible with GitLab CI's equivalent endpoint).
+func fetchOIDCToken(ctx context.Context, requestURL, requestToken string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build OIDC token request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request OIDC token from %s: %w", requestURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read OIDC token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("OIDC token endpoint %s returned status %d: %s", requestURL, resp.StatusCode, string(body))
+	}
+
+	token, err := parseOIDCTokenResponse(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse OIDC token response from %s: %w", requestURL, err)
+	}
+
+	return token, nil
+}
+
+// parseOIDCTokenResponse decodes the `{"value": "<jwt>"}` envelope used by
+// GitHub Actions' and GitLab CI's ID token endpoints.
+func parseOIDCTokenResponse(body []byte) (string, error) {
+	var payload struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", err
+	}
+	if payload.Value == "" {
+		return "", fmt.Errorf("response did not contain a 'value' field")
+	}
+	return payload.Value, nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity/cache"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultTokenCacheName is used as the keyring/file entry name when
+// `token_cache.name` is unset.
+const defaultTokenCacheName = "terraform-provider-microsoft365"
+
+// tokenCacheOptions builds the azidentity persistent cache and the
+// previously-persisted AuthenticationRecord (if any) for the
+// interactive_browser, device_code, and username_password auth methods. It
+// returns a zero-value cache and record when `token_cache` is unset or
+// disabled, in which case credentials fall back to azidentity's default
+// in-memory, per-process cache and always prompt.
+func tokenCacheOptions(ctx context.Context, data M365ProviderModel) (azidentity.Cache, azidentity.AuthenticationRecord, error) {
+	if data.TokenCache == nil || !data.TokenCache.Enabled.ValueBool() {
+		return azidentity.Cache{}, azidentity.AuthenticationRecord{}, nil
+	}
+
+	name := data.TokenCache.Name.ValueString()
+	if name == "" {
+		name = defaultTokenCacheName
+	}
+
+	persistentCache, err := cache.New(&cache.Options{Name: name})
+	if err != nil {
+		return azidentity.Cache{}, azidentity.AuthenticationRecord{}, fmt.Errorf(
+			"token_cache is enabled but the platform keyring is unavailable: %w. Disable token_cache or ensure "+
+				"a keyring/credential store is reachable on this host", err)
+	}
+
+	record, err := loadAuthenticationRecord(data.AuthenticationRecordPath.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "Could not read authentication_record_path; a fresh sign-in will be required", map[string]interface{}{
+			"authentication_record_path": data.AuthenticationRecordPath.ValueString(),
+			"error":                      err.Error(),
+		})
+	}
+
+	return persistentCache, record, nil
+}
+
+// loadAuthenticationRecord reads and deserializes a previously-persisted
+// MSAL AuthenticationRecord, so a credential can resume an existing session
+// instead of prompting the user again. An empty path or a file that doesn't
+// exist yet (e.g. first run) is not an error.
+func loadAuthenticationRecord(path string) (azidentity.AuthenticationRecord, error) {
+	if path == "" {
+		return azidentity.AuthenticationRecord{}, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return azidentity.AuthenticationRecord{}, nil
+		}
+		return azidentity.AuthenticationRecord{}, err
+	}
+
+	var record azidentity.AuthenticationRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return azidentity.AuthenticationRecord{}, fmt.Errorf("parsing authentication record: %w", err)
+	}
+
+	return record, nil
+}
+
+// persistAuthenticationRecordIfEnabled authenticates eagerly and persists
+// the resulting MSAL AuthenticationRecord to authentication_record_path, so
+// the next run can pass it back in via loadAuthenticationRecord and reuse
+// the signed-in account instead of prompting the user again. It is a no-op
+// when token_cache is unset or disabled.
+func persistAuthenticationRecordIfEnabled(
+	ctx context.Context,
+	data M365ProviderModel,
+	authenticate func(context.Context, *policy.TokenRequestOptions) (azidentity.AuthenticationRecord, error),
+) error {
+	if data.TokenCache == nil || !data.TokenCache.Enabled.ValueBool() {
+		return nil
+	}
+
+	record, err := authenticate(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("authenticating to populate the token cache: %w", err)
+	}
+
+	path := data.AuthenticationRecordPath.ValueString()
+	if path == "" {
+		return nil
+	}
+
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("serializing authentication record: %w", err)
+	}
+
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		return fmt.Errorf("writing authentication_record_path %q: %w", path, err)
+	}
+
+	tflog.Debug(ctx, "Persisted MSAL authentication record", map[string]interface{}{"authentication_record_path": path})
+	return nil
+}
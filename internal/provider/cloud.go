@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// cloudEndpoints holds the authority host, default Graph API scope, and
+// Graph v1.0/beta service roots needed to talk to a given Microsoft cloud.
+type cloudEndpoints struct {
+	authorityURL         string
+	apiScope             string
+	graphServiceRoot     string
+	graphBetaServiceRoot string
+}
+
+// builtInClouds is the fixed lookup table for every `cloud` value except
+// `custom`, which is resolved from the `custom_cloud` block instead by
+// resolveCustomCloudConstants.
+var builtInClouds = map[string]cloudEndpoints{
+	"public": {
+		authorityURL:         "https://login.microsoftonline.com/",
+		apiScope:             "https://graph.microsoft.com/.default",
+		graphServiceRoot:     "https://graph.microsoft.com/v1.0",
+		graphBetaServiceRoot: "https://graph.microsoft.com/beta",
+	},
+	"gcc": {
+		authorityURL:         "https://login.microsoftonline.com/",
+		apiScope:             "https://graph.microsoft.com/.default",
+		graphServiceRoot:     "https://graph.microsoft.com/v1.0",
+		graphBetaServiceRoot: "https://graph.microsoft.com/beta",
+	},
+	"gcchigh": {
+		authorityURL:         "https://login.microsoftonline.us/",
+		apiScope:             "https://graph.microsoft.us/.default",
+		graphServiceRoot:     "https://graph.microsoft.us/v1.0",
+		graphBetaServiceRoot: "https://graph.microsoft.us/beta",
+	},
+	"dod": {
+		authorityURL:         "https://login.microsoftonline.us/",
+		apiScope:             "https://dod-graph.microsoft.us/.default",
+		graphServiceRoot:     "https://dod-graph.microsoft.us/v1.0",
+		graphBetaServiceRoot: "https://dod-graph.microsoft.us/beta",
+	},
+	"china": {
+		authorityURL:         "https://login.partner.microsoftonline.cn/",
+		apiScope:             "https://microsoftgraph.chinacloudapi.cn/.default",
+		graphServiceRoot:     "https://microsoftgraph.chinacloudapi.cn/v1.0",
+		graphBetaServiceRoot: "https://microsoftgraph.chinacloudapi.cn/beta",
+	},
+	"ex": {
+		authorityURL:         "https://login.microsoftonline.eaglex.ic.gov/",
+		apiScope:             "https://graph.eaglex.ic.gov/.default",
+		graphServiceRoot:     "https://graph.eaglex.ic.gov/v1.0",
+		graphBetaServiceRoot: "https://graph.eaglex.ic.gov/beta",
+	},
+	"rx": {
+		authorityURL:         "https://login.microsoftonline.microsoft.scloud/",
+		apiScope:             "https://graph.microsoft.scloud/.default",
+		graphServiceRoot:     "https://graph.microsoft.scloud/v1.0",
+		graphBetaServiceRoot: "https://graph.microsoft.scloud/beta",
+	},
+}
+
+// setCloudConstants resolves the authority host, default API scope, and
+// Graph v1.0/beta service roots for a built-in `cloud` value.
+func setCloudConstants(cloudName string) (authorityURL, apiScope, graphServiceRoot, graphBetaServiceRoot string, err error) {
+	endpoints, ok := builtInClouds[cloudName]
+	if !ok {
+		return "", "", "", "", fmt.Errorf("unsupported cloud type %q", cloudName)
+	}
+
+	return endpoints.authorityURL, endpoints.apiScope, endpoints.graphServiceRoot, endpoints.graphBetaServiceRoot, nil
+}
+
+// resolveCustomCloudConstants resolves cloud endpoints from the
+// `custom_cloud` block for `cloud = "custom"`, for air-gapped/private Graph
+// instances, on-prem Azure Stack Hub deployments, and national clouds this
+// provider doesn't hard-code into builtInClouds yet. `authority_host` and
+// `graph_endpoint` are required since there's no sensible default for
+// either; `graph_beta_endpoint` and `api_scope` fall back to
+// `graph_endpoint` when unset.
+func resolveCustomCloudConstants(data M365ProviderModel) (authorityURL, apiScope, graphServiceRoot, graphBetaServiceRoot string, err error) {
+	if data.CustomCloud == nil {
+		return "", "", "", "", fmt.Errorf("cloud = \"custom\" requires a custom_cloud block")
+	}
+
+	authorityURL = data.CustomCloud.AuthorityHost.ValueString()
+	graphServiceRoot = data.CustomCloud.GraphEndpoint.ValueString()
+	if authorityURL == "" || graphServiceRoot == "" {
+		return "", "", "", "", fmt.Errorf("custom_cloud requires both authority_host and graph_endpoint to be set")
+	}
+
+	graphBetaServiceRoot = data.CustomCloud.GraphBetaEndpoint.ValueString()
+	if graphBetaServiceRoot == "" {
+		graphBetaServiceRoot = graphServiceRoot
+	}
+
+	apiScope = data.CustomCloud.APIScope.ValueString()
+	if apiScope == "" {
+		apiScope = graphServiceRoot + "/.default"
+	}
+
+	return authorityURL, apiScope, graphServiceRoot, graphBetaServiceRoot, nil
+}
+
+// cloudConfiguration builds an azcore/cloud.Configuration from resolved
+// endpoints, so every Entra ID credential type authenticates against the
+// authority and audience for the configured cloud - including `custom` -
+// instead of the azidentity default of the public cloud.
+func cloudConfiguration(authorityURL, apiScope, graphServiceRoot string) cloud.Configuration {
+	return cloud.Configuration{
+		ActiveDirectoryAuthorityHost: authorityURL,
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			cloud.ResourceManager: {
+				Endpoint: graphServiceRoot,
+				Audience: apiScope,
+			},
+		},
+	}
+}
+
+// configureEntraIDClientOptions builds the azcore/policy.ClientOptions
+// shared by every Entra ID credential type (client secret, certificate,
+// device code, OIDC, managed identity, etc.), with a dynamically-built
+// cloud.Configuration so credentials authenticate against the authority and
+// audience resolved for the configured `cloud` (or `custom_cloud`) value.
+func configureEntraIDClientOptions(ctx context.Context, useProxy bool, proxyURL string, authorityURL, apiScope, graphServiceRoot string, telemetryOptout bool) (policy.ClientOptions, error) {
+	options := policy.ClientOptions{
+		Cloud: cloudConfiguration(authorityURL, apiScope, graphServiceRoot),
+	}
+	options.Telemetry.Disabled = telemetryOptout
+
+	if useProxy {
+		if proxyURL == "" {
+			return policy.ClientOptions{}, fmt.Errorf("use_proxy is true but proxy_url is not set")
+		}
+
+		parsedProxyURL, err := url.Parse(proxyURL)
+		if err != nil {
+			return policy.ClientOptions{}, fmt.Errorf("invalid proxy_url %q: %w", proxyURL, err)
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyURL(parsedProxyURL)
+		options.Transport = &http.Client{Transport: transport}
+
+		tflog.Debug(ctx, "Configured Entra ID client options to use proxy", map[string]interface{}{
+			"proxy_url": proxyURL,
+		})
+	}
+
+	return options, nil
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	graphBetaAssignmentFilter "github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/deviceandappmanagement/beta/assignmentFilter"
+	graphCloudPcProvisioningPolicy "github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/devicemanagement/v1.0/cloudPcProvisioningPolicy"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/graphquery"
+	graphBetaAuthenticationStrengthPolicy "github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/identityandaccess/beta/authenticationstrengthpolicy"
+	graphBetaConditionalAccessPolicyEvaluation "github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/identityandaccess/beta/conditionalaccesspolicyevaluation"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/providerconfig"
+)
+
+// defaultServiceRegistrations is the built-in set of subsystems the
+// production provider registers. Third-party embedders and tests that want a
+// different set should pass WithServices to New rather than relying on this
+// list.
+func defaultServiceRegistrations() []ServiceRegistration {
+	return []ServiceRegistration{
+		graphBetaAssignmentFilter.Registration{},
+		graphBetaAuthenticationStrengthPolicy.Registration{},
+		graphBetaConditionalAccessPolicyEvaluation.Registration{},
+		graphCloudPcProvisioningPolicy.Registration{},
+		graphquery.Registration{},
+		providerconfig.Registration{},
+	}
+}
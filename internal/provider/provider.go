@@ -8,8 +8,10 @@ import (
 	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
 	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,28 +25,65 @@ var _ provider.Provider = &M365Provider{}
 
 // M365Provider defines the provider implementation.
 type M365Provider struct {
-	version string
-	clients *client.GraphClients
+	version  string
+	clients  *client.GraphClients
+	services []ServiceRegistration
 }
 
 // M365ProviderModel describes the provider data model.
 type M365ProviderModel struct {
-	TenantID                  types.String `tfsdk:"tenant_id"`
-	AuthMethod                types.String `tfsdk:"auth_method"`
-	ClientID                  types.String `tfsdk:"client_id"`
-	ClientSecret              types.String `tfsdk:"client_secret"`
-	ClientCertificateBase64   types.String `tfsdk:"client_certificate_base64"`
-	ClientCertificateFilePath types.String `tfsdk:"client_certificate_file_path"`
-	ClientCertificatePassword types.String `tfsdk:"client_certificate_password"`
-	Username                  types.String `tfsdk:"username"`
-	Password                  types.String `tfsdk:"password"`
-	RedirectURL               types.String `tfsdk:"redirect_url"`
-	UseProxy                  types.Bool   `tfsdk:"use_proxy"`
-	ProxyURL                  types.String `tfsdk:"proxy_url"`
-	Cloud                     types.String `tfsdk:"cloud"`
-	EnableChaos               types.Bool   `tfsdk:"enable_chaos"`
-	TelemetryOptout           types.Bool   `tfsdk:"telemetry_optout"`
-	Debug                     types.Bool   `tfsdk:"debug"`
+	TenantID                  types.String      `tfsdk:"tenant_id"`
+	AuthMethod                types.String      `tfsdk:"auth_method"`
+	ClientID                  types.String      `tfsdk:"client_id"`
+	ClientSecret              types.String      `tfsdk:"client_secret"`
+	ClientCertificateBase64   types.String      `tfsdk:"client_certificate_base64"`
+	ClientCertificateFilePath types.String      `tfsdk:"client_certificate_file_path"`
+	ClientCertificatePassword types.String      `tfsdk:"client_certificate_password"`
+	Username                  types.String      `tfsdk:"username"`
+	Password                  types.String      `tfsdk:"password"`
+	RedirectURL               types.String      `tfsdk:"redirect_url"`
+	UseProxy                  types.Bool        `tfsdk:"use_proxy"`
+	ProxyURL                  types.String      `tfsdk:"proxy_url"`
+	Cloud                     types.String      `tfsdk:"cloud"`
+	CustomCloud               *CustomCloudModel `tfsdk:"custom_cloud"`
+	EnableChaos               types.Bool        `tfsdk:"enable_chaos"`
+	TelemetryOptout           types.Bool        `tfsdk:"telemetry_optout"`
+	Debug                     types.Bool        `tfsdk:"debug"`
+	MaxRetries                types.Int64       `tfsdk:"max_retries"`
+	RetryMaxWaitSeconds       types.Int64       `tfsdk:"retry_max_wait_seconds"`
+	RetryOnStatus             types.List        `tfsdk:"retry_on_status"`
+	GraphEndpointOverride     types.String      `tfsdk:"graph_endpoint_override"`
+	GraphBetaEndpointOverride types.String      `tfsdk:"graph_beta_endpoint_override"`
+	OidcToken                 types.String      `tfsdk:"oidc_token"`
+	OidcTokenFilePath         types.String      `tfsdk:"oidc_token_file_path"`
+	OidcRequestURL            types.String      `tfsdk:"oidc_request_url"`
+	OidcRequestToken          types.String      `tfsdk:"oidc_request_token"`
+	ManagedIdentityClientID   types.String      `tfsdk:"managed_identity_client_id"`
+	Chain                     types.List        `tfsdk:"chain"`
+	TokenCache                *TokenCacheModel  `tfsdk:"token_cache"`
+	AuthenticationRecordPath  types.String      `tfsdk:"authentication_record_path"`
+	BatchRequests             types.Bool        `tfsdk:"batch_requests"`
+	ConditionalAccessWhatIf   types.Bool        `tfsdk:"conditional_access_whatif"`
+}
+
+// TokenCacheModel describes the `token_cache` block, which enables
+// azidentity's persistent token cache for the interactive_browser,
+// device_code, and username_password auth methods.
+type TokenCacheModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Name    types.String `tfsdk:"name"`
+}
+
+// CustomCloudModel describes the `custom_cloud` block used with
+// `cloud = "custom"` to target an air-gapped/private Graph instance, an
+// on-prem Azure Stack Hub deployment, or a national cloud this provider
+// doesn't hard-code yet.
+type CustomCloudModel struct {
+	AuthorityHost          types.String `tfsdk:"authority_host"`
+	GraphEndpoint          types.String `tfsdk:"graph_endpoint"`
+	GraphBetaEndpoint      types.String `tfsdk:"graph_beta_endpoint"`
+	APIScope               types.String `tfsdk:"api_scope"`
+	TenantEndpointOverride types.String `tfsdk:"tenant_endpoint_override"`
 }
 
 func (p *M365Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -57,23 +96,61 @@ func (p *M365Provider) Schema(ctx context.Context, req provider.SchemaRequest, r
 		Attributes: map[string]schema.Attribute{
 			"cloud": schema.StringAttribute{
 				Description: "The cloud to use for authentication and Graph / Graph Beta API requests." +
-					"Default is `public`. Valid values are `public`, `gcc`, `gcchigh`, `china`, `dod`, `ex`, `rx`." +
+					"Default is `public`. Valid values are `public`, `gcc`, `gcchigh`, `china`, `dod`, `ex`, `rx`, `custom`." +
+					"`custom` requires the `custom_cloud` block to be set. " +
 					"Can also be set using the `M365_CLOUD` environment variable.",
 				MarkdownDescription: "The cloud to use for authentication and Graph / Graph Beta API requests." +
-					"Default is `public`. Valid values are `public`, `gcc`, `gcchigh`, `china`, `dod`, `ex`, `rx`." +
+					"Default is `public`. Valid values are `public`, `gcc`, `gcchigh`, `china`, `dod`, `ex`, `rx`, `custom`." +
+					"`custom` requires the `custom_cloud` block to be set. " +
 					"Can also be set using the `M365_CLOUD` environment variable.",
 				Required: true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("public", "gcc", "gcchigh", "china", "dod", "ex", "rx"),
+					stringvalidator.OneOf("public", "gcc", "gcchigh", "china", "dod", "ex", "rx", "custom"),
+				},
+			},
+			"custom_cloud": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Endpoint overrides for `cloud = \"custom\"`, used for air-gapped/private Graph " +
+					"instances, on-prem Azure Stack Hub deployments, and national clouds not otherwise built " +
+					"into the provider. `authority_host` and `graph_endpoint` are required; the others default " +
+					"from `graph_endpoint` when unset.",
+				MarkdownDescription: "Endpoint overrides for `cloud = \"custom\"`, used for air-gapped/private Graph " +
+					"instances, on-prem Azure Stack Hub deployments, and national clouds not otherwise built " +
+					"into the provider. `authority_host` and `graph_endpoint` are required; the others default " +
+					"from `graph_endpoint` when unset.",
+				Attributes: map[string]schema.Attribute{
+					"authority_host": schema.StringAttribute{
+						Required:    true,
+						Description: "The Entra ID authority host to acquire tokens from, e.g. `https://login.contoso.local/`.",
+					},
+					"graph_endpoint": schema.StringAttribute{
+						Required:    true,
+						Description: "The Graph v1.0 service root to send requests to, e.g. `https://graph.contoso.local/v1.0`.",
+					},
+					"graph_beta_endpoint": schema.StringAttribute{
+						Optional:    true,
+						Description: "The Graph beta service root. Defaults to `graph_endpoint` when unset.",
+					},
+					"api_scope": schema.StringAttribute{
+						Optional:    true,
+						Description: "The OAuth scope to request tokens for. Defaults to `graph_endpoint` + `/.default` when unset.",
+					},
+					"tenant_endpoint_override": schema.StringAttribute{
+						Optional: true,
+						Description: "Accepted in configuration but not read by the provider: tokens are always requested " +
+							"from `authority_host`, and this value does not change the tenant-specific authority endpoint.",
+					},
 				},
 			},
 			"auth_method": schema.StringAttribute{
 				Required: true,
 				Description: "The authentication method to use for the Entra ID application to authenticate the provider. " +
 					"Options: 'device_code', 'client_secret', 'client_certificate', 'interactive_browser', " +
-					"'username_password'. Can also be set using the `M365_AUTH_METHOD` environment variable.",
+					"'username_password', 'oidc', 'workload_identity', 'managed_identity', 'default', 'chained'. " +
+					"Can also be set using the `M365_AUTH_METHOD` environment variable.",
 				Validators: []validator.String{
-					stringvalidator.OneOf("device_code", "client_secret", "client_certificate", "interactive_browser", "username_password"),
+					stringvalidator.OneOf("device_code", "client_secret", "client_certificate", "interactive_browser",
+						"username_password", "oidc", "workload_identity", "managed_identity", "default", "chained"),
 				},
 			},
 			"tenant_id": schema.StringAttribute{
@@ -191,6 +268,111 @@ func (p *M365Provider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				MarkdownDescription: "Flag to enable debug mode for the provider." +
 					"Can also be set using the `M365_DEBUG_MODE` environment variable.",
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "The maximum number of times to retry a Microsoft Graph request that fails with a " +
+					"429 or transient 5xx response. Default is `3`. " +
+					"Can also be set using the `M365_MAX_RETRIES` environment variable.",
+			},
+			"retry_max_wait_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "The maximum number of seconds to wait before a single retry, regardless of the " +
+					"Retry-After value returned by Graph. Default is `30`. " +
+					"Can also be set using the `M365_RETRY_MAX_WAIT_SECONDS` environment variable.",
+			},
+			"retry_on_status": schema.ListAttribute{
+				Optional: true,
+				Description: "The HTTP status codes that trigger a retry, in addition to honoring any Retry-After " +
+					"header present on the response. Defaults to `[429, 502, 503, 504]`.",
+				ElementType: types.Int64Type,
+			},
+			"graph_endpoint_override": schema.StringAttribute{
+				Optional: true,
+				Description: "Overrides the Microsoft Graph v1.0 service root otherwise derived from `cloud`. " +
+					"Intended for pointing the provider at a local mock server in tests; not for production use.",
+			},
+			"graph_beta_endpoint_override": schema.StringAttribute{
+				Optional: true,
+				Description: "Overrides the Microsoft Graph beta service root otherwise derived from `cloud`. " +
+					"Intended for pointing the provider at a local mock server in tests; not for production use.",
+			},
+			"oidc_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "A static OIDC/JWT assertion to federate with the Entra ID application, for use with " +
+					"`auth_method = \"oidc\"` or `\"workload_identity\"`. Takes precedence over `oidc_token_file_path` " +
+					"and `oidc_request_url`/`oidc_request_token` when more than one is set. Can also be set using the `M365_OIDC_TOKEN` " +
+					"environment variable.",
+			},
+			"oidc_token_file_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a file containing an OIDC/JWT assertion, re-read on every token request. " +
+					"Used for Kubernetes-projected service account tokens (e.g. AKS workload identity federation). " +
+					"Can also be set using the `M365_OIDC_TOKEN_FILE_PATH` environment variable.",
+			},
+			"oidc_request_url": schema.StringAttribute{
+				Optional: true,
+				Description: "The URL of an OIDC token exchange endpoint to request an assertion from on every " +
+					"token request, e.g. a CI provider's ID token endpoint. Used together with `oidc_request_token`. " +
+					"Defaults to the `ACTIONS_ID_TOKEN_REQUEST_URL` environment variable, as set by GitHub Actions.",
+			},
+			"oidc_request_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The bearer token used to authenticate the request to `oidc_request_url`. Defaults to the " +
+					"`ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variable, as set by GitHub Actions.",
+			},
+			"managed_identity_client_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The client ID of a user-assigned managed identity to use with " +
+					"`auth_method = \"managed_identity\"`. Leave unset to use the system-assigned managed identity. " +
+					"Can also be set using the `M365_MANAGED_IDENTITY_CLIENT_ID` environment variable.",
+			},
+			"chain": schema.ListAttribute{
+				Optional: true,
+				Description: "For `auth_method = \"chained\"`, the ordered list of sub auth methods to try, each using " +
+					"the credential-specific attributes already configured elsewhere in this block. Supported values: " +
+					"'client_secret', 'managed_identity', 'oidc', 'workload_identity', 'azure_cli', 'environment'.",
+				ElementType: types.StringType,
+			},
+			"token_cache": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Enables azidentity's persistent token cache for `auth_method = \"interactive_browser\"`, " +
+					"`\"device_code\"`, and `\"username_password\"`, so a user isn't re-prompted on every `terraform plan`.",
+				MarkdownDescription: "Enables azidentity's persistent token cache for `auth_method = \"interactive_browser\"`, " +
+					"`\"device_code\"`, and `\"username_password\"`, so a user isn't re-prompted on every `terraform plan`.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether to persist tokens to the platform keyring/credential store instead of only in-memory.",
+					},
+					"name": schema.StringAttribute{
+						Optional: true,
+						Description: "The keyring/file entry name the cache is stored under. Defaults to " +
+							"`terraform-provider-microsoft365` when unset.",
+					},
+				},
+			},
+			"authentication_record_path": schema.StringAttribute{
+				Optional: true,
+				Description: "File path where the MSAL AuthenticationRecord is JSON-serialized after first sign-in " +
+					"and read back on subsequent runs, so the cached account can be resumed. Used together with " +
+					"`token_cache`. Can also be set using the `M365_AUTHENTICATION_RECORD_PATH` environment variable.",
+			},
+			"batch_requests": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, resources that support it coalesce their Graph Get/Post/Patch/Delete calls " +
+					"into `POST /$batch` requests, which substantially reduces 429 throttling under " +
+					"`terraform apply -parallelism=N` on large configurations. Defaults to false. Can also be set " +
+					"using the `M365_BATCH_REQUESTS` environment variable.",
+			},
+			"conditional_access_whatif": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, enables the `microsoft365_graph_beta_conditional_access_policy_evaluation` " +
+					"data source's dry-run evaluation of a conditional access policy against the " +
+					"`/identity/conditionalAccess/evaluate` preview endpoint. Defaults to false. Can also be set " +
+					"using the `M365_CONDITIONAL_ACCESS_WHATIF` environment variable.",
+			},
 		},
 	}
 }
@@ -239,6 +421,10 @@ func (p *M365Provider) Configure(ctx context.Context, req provider.ConfigureRequ
 	enableChaos := helpers.GetValueOrEnvBool(ctx, data.EnableChaos, "M365_ENABLE_CHAOS")
 	telemetryOptout := helpers.GetValueOrEnvBool(ctx, data.TelemetryOptout, "M365_TELEMETRY_OPTOUT")
 	debugMode := helpers.GetValueOrEnvBool(ctx, data.Debug, "M365_DEBUG_MODE")
+	authenticationRecordPath := helpers.GetValueOrEnv(ctx, data.AuthenticationRecordPath, "M365_AUTHENTICATION_RECORD_PATH")
+	batchRequests := helpers.GetValueOrEnvBool(ctx, data.BatchRequests, "M365_BATCH_REQUESTS")
+	conditionalAccessWhatIf := helpers.GetValueOrEnvBool(ctx, data.ConditionalAccessWhatIf, "M365_CONDITIONAL_ACCESS_WHATIF")
+	retryOptions := resolveRetryTransportOptions(ctx, data)
 
 	data.TenantID = types.StringValue(tenantID)
 	data.AuthMethod = types.StringValue(authMethod)
@@ -256,6 +442,9 @@ func (p *M365Provider) Configure(ctx context.Context, req provider.ConfigureRequ
 	data.EnableChaos = types.BoolValue(enableChaos)
 	data.TelemetryOptout = types.BoolValue(telemetryOptout)
 	data.Debug = types.BoolValue(debugMode)
+	data.AuthenticationRecordPath = types.StringValue(authenticationRecordPath)
+	data.BatchRequests = types.BoolValue(batchRequests)
+	data.ConditionalAccessWhatIf = types.BoolValue(conditionalAccessWhatIf)
 
 	tflog.Debug(ctx, "M365ProviderModel after population", map[string]interface{}{
 		"tenant_id_length":                 len(data.TenantID.ValueString()),
@@ -301,7 +490,18 @@ func (p *M365Provider) Configure(ctx context.Context, req provider.ConfigureRequ
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "client_id")
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "client_secret")
 
-	authorityURL, apiScope, graphServiceRoot, graphBetaServiceRoot, err := setCloudConstants(cloud)
+	var (
+		authorityURL         string
+		apiScope             string
+		graphServiceRoot     string
+		graphBetaServiceRoot string
+		err                  error
+	)
+	if cloud == "custom" {
+		authorityURL, apiScope, graphServiceRoot, graphBetaServiceRoot, err = resolveCustomCloudConstants(data)
+	} else {
+		authorityURL, apiScope, graphServiceRoot, graphBetaServiceRoot, err = setCloudConstants(cloud)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Microsoft Cloud Type",
@@ -311,12 +511,19 @@ func (p *M365Provider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	if !data.GraphEndpointOverride.IsNull() && data.GraphEndpointOverride.ValueString() != "" {
+		graphServiceRoot = data.GraphEndpointOverride.ValueString()
+	}
+	if !data.GraphBetaEndpointOverride.IsNull() && data.GraphBetaEndpointOverride.ValueString() != "" {
+		graphBetaServiceRoot = data.GraphBetaEndpointOverride.ValueString()
+	}
+
 	ctx = tflog.SetField(ctx, "authority_url", authorityURL)
 	ctx = tflog.SetField(ctx, "api_scope", apiScope)
 	ctx = tflog.SetField(ctx, "graph_service_root", graphServiceRoot)
 	ctx = tflog.SetField(ctx, "graph_beta_service_root", graphBetaServiceRoot)
 
-	clientOptions, err := configureEntraIDClientOptions(ctx, useProxy, proxyURL, authorityURL, telemetryOptout)
+	clientOptions, err := configureEntraIDClientOptions(ctx, useProxy, proxyURL, authorityURL, apiScope, graphServiceRoot, telemetryOptout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to configure client options",
@@ -355,6 +562,7 @@ func (p *M365Provider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 		return
 	}
+	httpClient.Transport = client.NewRetryTransport(httpClient.Transport, retryOptions)
 
 	stableAdapter, err := msgraphsdk.NewGraphRequestAdapterWithParseNodeFactoryAndSerializationWriterFactoryAndHttpClient(
 		authProvider, nil, nil, httpClient)
@@ -380,8 +588,27 @@ func (p *M365Provider) Configure(ctx context.Context, req provider.ConfigureRequ
 	betaAdapter.SetBaseUrl(graphBetaServiceRoot)
 
 	clients := &client.GraphClients{
-		StableClient: msgraphsdk.NewGraphServiceClient(stableAdapter),
-		BetaClient:   msgraphbetasdk.NewGraphServiceClient(betaAdapter),
+		StableClient:            msgraphsdk.NewGraphServiceClient(stableAdapter),
+		BetaClient:              msgraphbetasdk.NewGraphServiceClient(betaAdapter),
+		HTTPClient:              httpClient,
+		Credential:              cred,
+		APIScope:                apiScope,
+		GraphServiceRoot:        graphServiceRoot,
+		GraphBetaServiceRoot:    graphBetaServiceRoot,
+		AuthorityURL:            authorityURL,
+		Cloud:                   cloud,
+		AuthMethod:              authMethod,
+		TenantID:                tenantID,
+		ClientID:                clientID,
+		UseProxy:                useProxy,
+		ProviderVersion:         p.version,
+		BatchRequests:           batchRequests,
+		ConditionalAccessWhatIf: conditionalAccessWhatIf,
+	}
+
+	if batchRequests {
+		clients.StableBatch = client.NewBatchExecutor(stableAdapter)
+		clients.BetaBatch = client.NewBatchExecutor(betaAdapter)
 	}
 
 	p.clients = clients
@@ -395,12 +622,64 @@ func (p *M365Provider) Configure(ctx context.Context, req provider.ConfigureRequ
 	})
 }
 
+// Resources returns the constructors for every resource contributed by the
+// provider's registered services, ordered by service name.
+func (p *M365Provider) Resources(ctx context.Context) []func() resource.Resource {
+	var resources []func() resource.Resource
+	for _, svc := range p.orderedServices() {
+		resources = append(resources, svc.Resources()...)
+	}
+	return resources
+}
+
+// DataSources returns the constructors for every data source contributed by
+// the provider's registered services, ordered by service name.
+func (p *M365Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	var dataSources []func() datasource.DataSource
+	for _, svc := range p.orderedServices() {
+		dataSources = append(dataSources, svc.DataSources()...)
+	}
+	return dataSources
+}
+
+// orderedServices returns the provider's service registrations sorted by
+// name. Two registrations claiming the same name is a wiring bug in the
+// provider or in a third-party embedder's WithServices call, not something a
+// practitioner can work around, so it panics with the offending name rather
+// than surfacing a diagnostic.
+func (p *M365Provider) orderedServices() []ServiceRegistration {
+	services, err := sortedServiceRegistrations(p.services)
+	if err != nil {
+		panic(fmt.Sprintf("microsoft365 provider: %s", err))
+	}
+	return services
+}
+
+// Option configures optional behavior of New.
+type Option func(*M365Provider)
+
+// WithServices overrides the provider's default set of registered services.
+// Intended for third-party embedding of this provider under a different
+// name/version and for tests that want to exercise a minimal set of
+// resources/data sources without wiring every subsystem.
+func WithServices(services ...ServiceRegistration) Option {
+	return func(p *M365Provider) {
+		p.services = services
+	}
+}
+
 // New returns a new provider.Provider instance for the Microsoft365 provider.
-func New(version string) func() provider.Provider {
+// By default it registers every built-in subsystem; pass WithServices to
+// override that set.
+func New(version string, opts ...Option) func() provider.Provider {
 	return func() provider.Provider {
 		p := &M365Provider{
-			version: version,
-			clients: &client.GraphClients{},
+			version:  version,
+			clients:  &client.GraphClients{},
+			services: defaultServiceRegistrations(),
+		}
+		for _, opt := range opts {
+			opt(p)
 		}
 		return p
 	}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resolveRetryTransportOptions derives client.RetryTransportOptions from the
+// provider configuration, falling back to the M365_MAX_RETRIES,
+// M365_RETRY_MAX_WAIT_SECONDS and M365_RETRY_ON_STATUS environment variables,
+// and finally to client.NewRetryTransport's own defaults.
+func resolveRetryTransportOptions(ctx context.Context, data M365ProviderModel) client.RetryTransportOptions {
+	var options client.RetryTransportOptions
+
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		options.MaxRetries = int(data.MaxRetries.ValueInt64())
+	} else if v, ok := os.LookupEnv("M365_MAX_RETRIES"); ok {
+		if parsed, err := strconv.Atoi(v); err == nil {
+			options.MaxRetries = parsed
+		} else {
+			tflog.Warn(ctx, "Ignoring invalid M365_MAX_RETRIES value", map[string]interface{}{"value": v})
+		}
+	}
+
+	if !data.RetryMaxWaitSeconds.IsNull() && !data.RetryMaxWaitSeconds.IsUnknown() {
+		options.MaxWait = time.Duration(data.RetryMaxWaitSeconds.ValueInt64()) * time.Second
+	} else if v, ok := os.LookupEnv("M365_RETRY_MAX_WAIT_SECONDS"); ok {
+		if parsed, err := strconv.Atoi(v); err == nil {
+			options.MaxWait = time.Duration(parsed) * time.Second
+		} else {
+			tflog.Warn(ctx, "Ignoring invalid M365_RETRY_MAX_WAIT_SECONDS value", map[string]interface{}{"value": v})
+		}
+	}
+
+	if !data.RetryOnStatus.IsNull() && !data.RetryOnStatus.IsUnknown() {
+		var statuses []int64
+		data.RetryOnStatus.ElementsAs(ctx, &statuses, false)
+		for _, status := range statuses {
+			options.RetryOnStatus = append(options.RetryOnStatus, int(status))
+		}
+	} else if v, ok := os.LookupEnv("M365_RETRY_ON_STATUS"); ok {
+		for _, part := range strings.Split(v, ",") {
+			if parsed, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+				options.RetryOnStatus = append(options.RetryOnStatus, parsed)
+			}
+		}
+	}
+
+	return options
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ServiceRegistration is implemented by each Intune/Entra/Exchange subsystem
+// to advertise the resources and data sources it contributes to the
+// provider. Registrations live next to the domain code they describe (e.g.
+// internal/resources/.../registration.go), so adding a new subsystem is a
+// one-file change: implement this interface and add an instance to
+// defaultServiceRegistrations, or pass it to WithServices for a custom build.
+type ServiceRegistration interface {
+	// Name is a short, unique identifier for the subsystem, e.g.
+	// "device_and_app_management/assignment_filter". It is used to order
+	// registrations deterministically and to identify the offending
+	// registrations in a duplicate-name panic; it is never surfaced to
+	// Terraform.
+	Name() string
+
+	// Resources returns the constructors for the resources this subsystem
+	// contributes to the provider.
+	Resources() []func() resource.Resource
+
+	// DataSources returns the constructors for the data sources this
+	// subsystem contributes to the provider.
+	DataSources() []func() datasource.DataSource
+}
+
+// sortedServiceRegistrations returns services ordered by Name, so the
+// provider's resource/data source list is stable regardless of the order
+// registrations were supplied in. It errors if two registrations claim the
+// same name.
+func sortedServiceRegistrations(services []ServiceRegistration) ([]ServiceRegistration, error) {
+	sorted := make([]ServiceRegistration, len(services))
+	copy(sorted, services)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name() < sorted[j].Name() })
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Name() == sorted[i].Name() {
+			return nil, fmt.Errorf("duplicate service registration name %q", sorted[i].Name())
+		}
+	}
+
+	return sorted, nil
+}
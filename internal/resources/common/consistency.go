@@ -0,0 +1,75 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ChangeFunc is polled by the WaitFor* helpers below until the resource
+// being watched reaches a terminal state. It returns (nil, nil) while the
+// resource isn't there yet and polling should continue, a non-nil *bool
+// once a terminal state is reached (true for the desired target state,
+// false for some other terminal state that will never become the
+// target), or a non-nil error to abort the wait immediately.
+type ChangeFunc func(ctx context.Context) (*bool, error)
+
+const (
+	minPollInterval = 5 * time.Second
+	maxPollInterval = 30 * time.Second
+)
+
+// waitFor polls change on an exponential backoff (5s growing to 30s, with
+// jitter) until it reports a terminal state, ctx's deadline (derived from
+// the resource's timeouts block) is reached, or change returns an error.
+// It mirrors the eventually-consistent wait pattern the azuread provider
+// uses for its WaitForUpdate/WaitForDeletion helpers, adapted to poll
+// Microsoft Graph directly instead of driving an SDKv2 StateChangeConf.
+func waitFor(ctx context.Context, change ChangeFunc, notReachedMessage string) error {
+	interval := minPollInterval
+
+	for {
+		done, err := change(ctx)
+		if err != nil {
+			return err
+		}
+		if done != nil {
+			if !*done {
+				return fmt.Errorf("%s", notReachedMessage)
+			}
+			return nil
+		}
+
+		jitter := time.Duration(rand.Int63n(int64(interval) / 2))
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %w", notReachedMessage, ctx.Err())
+		case <-time.After(interval + jitter):
+		}
+
+		if interval *= 2; interval > maxPollInterval {
+			interval = maxPollInterval
+		}
+	}
+}
+
+// WaitForCreate polls change until a just-created object is visible under
+// its new ID and its fields match the plan, riding out the eventual
+// consistency window immediately after a POST.
+func WaitForCreate(ctx context.Context, change ChangeFunc) error {
+	return waitFor(ctx, change, "timed out waiting for the created resource to become consistent")
+}
+
+// WaitForUpdate polls change until a caller-supplied predicate over the
+// re-read object reports that the update has propagated.
+func WaitForUpdate(ctx context.Context, change ChangeFunc) error {
+	return waitFor(ctx, change, "timed out waiting for the updated resource to become consistent")
+}
+
+// WaitForDeletion polls change until a GET for the deleted object returns
+// 404, riding out the eventual consistency window immediately after a
+// DELETE.
+func WaitForDeletion(ctx context.Context, change ChangeFunc) error {
+	return waitFor(ctx, change, "timed out waiting for the resource to finish deleting")
+}
@@ -0,0 +1,58 @@
+// Package common holds small helpers shared across resource packages that
+// would otherwise be copy-pasted into every Graph-backed resource.
+package common
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+
+	betaodataerrors "github.com/microsoftgraph/msgraph-beta-sdk-go/models/odataerrors"
+	odataerrors "github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
+)
+
+// IsNotFoundError reports whether err is a Microsoft Graph OData error
+// representing a 404 / resource-not-found response, from either the
+// stable or beta SDK. Resources use this to decide whether a missing
+// object should be removed from state, and the eventual-consistency
+// helpers in consistency.go use it to decide whether a just-created or
+// just-deleted object simply hasn't caught up yet.
+func IsNotFoundError(err error) bool {
+	var stableErr *odataerrors.ODataError
+	if errors.As(err, &stableErr) {
+		return stableErr.ResponseStatusCode == http.StatusNotFound || hasNotFoundCode(stableErr.GetErrorEscaped())
+	}
+
+	var betaErr *betaodataerrors.ODataError
+	if errors.As(err, &betaErr) {
+		return betaErr.ResponseStatusCode == http.StatusNotFound || hasNotFoundCode(betaErr.GetErrorEscaped())
+	}
+
+	return false
+}
+
+// codeGetter is satisfied by both the stable and beta SDKs' main-error
+// models; it lets hasNotFoundCode stay SDK-agnostic.
+type codeGetter interface {
+	GetCode() *string
+}
+
+// hasNotFoundCode inspects the OData error's inner "code" for the values
+// Graph uses to mean "not found" when it doesn't also set a 404 status.
+func hasNotFoundCode(mainError codeGetter) bool {
+	if mainError == nil || reflect.ValueOf(mainError).IsNil() {
+		return false
+	}
+
+	code := mainError.GetCode()
+	if code == nil {
+		return false
+	}
+
+	switch *code {
+	case "ResourceNotFound", "ItemNotFound", "Request_ResourceNotFound", "NotFound":
+		return true
+	default:
+		return false
+	}
+}
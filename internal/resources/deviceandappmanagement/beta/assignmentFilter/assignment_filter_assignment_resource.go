@@ -0,0 +1,401 @@
+// REF: https://learn.microsoft.com/en-us/graph/api/intune-policyset-deviceandappmanagementassignmentfilter-update?view=graph-rest-beta
+package graphBetaAssignmentFilter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	"github.com/microsoftgraph/msgraph-beta-sdk-go/models"
+)
+
+var _ resource.Resource = &AssignmentFilterAssignmentResource{}
+var _ resource.ResourceWithConfigure = &AssignmentFilterAssignmentResource{}
+var _ resource.ResourceWithImportState = &AssignmentFilterAssignmentResource{}
+
+func NewAssignmentFilterAssignmentResource() resource.Resource {
+	return &AssignmentFilterAssignmentResource{}
+}
+
+// AssignmentFilterAssignmentResource binds an existing assignment filter to a
+// single policy or app assignment by updating the filter's `payloads`
+// collection (see graphBetaAssignmentFilter), so each binding is modeled as
+// its own resource here rather than as a nested block, mirroring how this
+// provider splits other many-to-many Intune relationships out of their
+// aggregate resource. `payloads` is a single collection shared by every
+// binding of a given filter, so every read-modify-write against it is
+// serialized per assignment_filter_id (see filterPayloadLocks) - otherwise
+// two bindings of the same filter applied in one plan could race and the
+// slower write would silently drop the faster one's payload.
+type AssignmentFilterAssignmentResource struct {
+	client           *msgraphbetasdk.GraphServiceClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+// filterPayloadLocks serializes read-modify-write updates to an assignment
+// filter's payloads collection, keyed by assignment_filter_id, since Graph
+// has no per-payload PATCH for this binding: putAssignment and Delete each
+// read the whole collection, add/remove one entry, and write the whole
+// collection back.
+var filterPayloadLocks sync.Map
+
+// lockFilterPayloads acquires the per-filter lock for assignmentFilterID,
+// creating it on first use, and returns a func to release it.
+func lockFilterPayloads(assignmentFilterID string) func() {
+	value, _ := filterPayloadLocks.LoadOrStore(assignmentFilterID, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+type AssignmentFilterAssignmentResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	AssignmentFilterID   types.String   `tfsdk:"assignment_filter_id"`
+	PayloadID            types.String   `tfsdk:"payload_id"`
+	PayloadType          types.String   `tfsdk:"payload_type"`
+	GroupID              types.String   `tfsdk:"group_id"`
+	AssignmentFilterType types.String   `tfsdk:"assignment_filter_type"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *AssignmentFilterAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_beta_device_and_app_management_assignment_filter_assignment"
+}
+
+// Configure sets the client for the resource.
+func (r *AssignmentFilterAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Configuring AssignmentFilterAssignmentResource")
+
+	if req.ProviderData == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we reject this. Please report this to the provider developers.",
+		)
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if clients.BetaClient == nil {
+		resp.Diagnostics.AddError(
+			"BetaClient is nil",
+			"The BetaClient in the provider data is nil. This could indicate a configuration error in the provider. Please check your provider configuration and try again.",
+		)
+		return
+	}
+
+	r.client = clients.BetaClient
+	tflog.Debug(ctx, "Initialized graphBetaAssignmentFilter assignment resource with BetaClient")
+}
+
+// ImportState imports the resource state from `<assignment_filter_id>/<payload_id>`.
+func (r *AssignmentFilterAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the form <assignment_filter_id>/<payload_id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("assignment_filter_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("payload_id"), parts[1])...)
+}
+
+// Schema returns the schema for the resource.
+func (r *AssignmentFilterAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Binds an existing `graph_beta_device_and_app_management_assignment_filter` to a single policy or " +
+			"app assignment. Each binding is its own resource so that a filter can be reused across many assignments " +
+			"without managing its whole `payloads` list in one place. Creating, updating or deleting a binding " +
+			"rewrites the filter's `payloads`, so it needs write access to the filter itself.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of this assignment filter binding, `<assignment_filter_id>/<payload_id>`.",
+			},
+			"assignment_filter_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the assignment filter to bind.",
+			},
+			"payload_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the policy or app assignment the filter is bound to.",
+			},
+			"payload_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the payload being bound, e.g. `deviceConfiguration` or `mobileApp`.",
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Entra ID group the payload's assignment targets.",
+			},
+			"assignment_filter_type": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Whether matching devices are included in or excluded from the assignment. Supported types: %v", getAllAssignmentFilterTypes()),
+				Validators: []validator.String{
+					assignmentFilterTypeValidator{},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create handles the Create operation.
+func (r *AssignmentFilterAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AssignmentFilterAssignmentResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.putAssignment(ctx, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating assignment filter assignment",
+			fmt.Sprintf("Could not bind assignment filter %s to payload %s: %s", data.AssignmentFilterID.ValueString(), data.PayloadID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.AssignmentFilterID.ValueString(), data.PayloadID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+}
+
+// Read handles the read operation and stating.
+func (r *AssignmentFilterAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AssignmentFilterAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	assignmentFilter, err := r.client.DeviceManagement().AssignmentFilters().
+		ByDeviceAndAppManagementAssignmentFilterId(data.AssignmentFilterID.ValueString()).Get(ctx, nil)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Assignment filter not found",
+				fmt.Sprintf("Assignment filter with ID %s was not found. Removing binding from state.", data.AssignmentFilterID.ValueString()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading assignment filter assignment",
+			fmt.Sprintf("Could not read assignment filter %s: %s", data.AssignmentFilterID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	found := false
+	for _, payload := range assignmentFilter.GetPayloads() {
+		if payload.GetPayloadId() == nil || *payload.GetPayloadId() != data.PayloadID.ValueString() {
+			continue
+		}
+		found = true
+		if payload.GetPayloadType() != nil {
+			data.PayloadType = types.StringValue(*payload.GetPayloadType())
+		}
+		if payload.GetGroupId() != nil {
+			data.GroupID = types.StringValue(*payload.GetGroupId())
+		}
+		if payload.GetAssignmentFilterType() != nil {
+			data.AssignmentFilterType = types.StringValue(payload.GetAssignmentFilterType().String())
+		}
+	}
+
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Assignment filter binding not found",
+			fmt.Sprintf("Payload %s is no longer bound to assignment filter %s. Removing from state.", data.PayloadID.ValueString(), data.AssignmentFilterID.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update handles the Update operation.
+func (r *AssignmentFilterAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AssignmentFilterAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if err := r.putAssignment(ctx, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating assignment filter assignment",
+			fmt.Sprintf("Could not update binding of assignment filter %s to payload %s: %s", data.AssignmentFilterID.ValueString(), data.PayloadID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete handles the Delete operation.
+func (r *AssignmentFilterAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AssignmentFilterAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	unlock := lockFilterPayloads(data.AssignmentFilterID.ValueString())
+	defer unlock()
+
+	assignmentFilter, err := r.client.DeviceManagement().AssignmentFilters().
+		ByDeviceAndAppManagementAssignmentFilterId(data.AssignmentFilterID.ValueString()).Get(ctx, nil)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading assignment filter before removing assignment",
+			fmt.Sprintf("Could not read assignment filter %s: %s", data.AssignmentFilterID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	remaining := make([]models.AssignmentFilterPayloadable, 0)
+	for _, payload := range assignmentFilter.GetPayloads() {
+		if payload.GetPayloadId() != nil && *payload.GetPayloadId() == data.PayloadID.ValueString() {
+			continue
+		}
+		remaining = append(remaining, payload)
+	}
+
+	requestBody := models.NewDeviceAndAppManagementAssignmentFilter()
+	requestBody.SetPayloads(remaining)
+
+	_, err = r.client.DeviceManagement().AssignmentFilters().
+		ByDeviceAndAppManagementAssignmentFilterId(data.AssignmentFilterID.ValueString()).Patch(ctx, requestBody, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting assignment filter assignment",
+			fmt.Sprintf("Could not remove payload %s from assignment filter %s: %s", data.PayloadID.ValueString(), data.AssignmentFilterID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// putAssignment upserts data's binding into the assignment filter's payload
+// collection, leaving any other payloads already bound to it untouched. The
+// read-modify-write is serialized per assignment_filter_id; see
+// filterPayloadLocks.
+func (r *AssignmentFilterAssignmentResource) putAssignment(ctx context.Context, data *AssignmentFilterAssignmentResourceModel) error {
+	unlock := lockFilterPayloads(data.AssignmentFilterID.ValueString())
+	defer unlock()
+
+	assignmentFilter, err := r.client.DeviceManagement().AssignmentFilters().
+		ByDeviceAndAppManagementAssignmentFilterId(data.AssignmentFilterID.ValueString()).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	filterType, err := models.ParseDeviceAndAppManagementAssignmentFilterType(data.AssignmentFilterType.ValueString())
+	if err != nil {
+		return err
+	}
+
+	payload := models.NewAssignmentFilterPayload()
+	payloadID := data.PayloadID.ValueString()
+	payloadType := data.PayloadType.ValueString()
+	groupID := data.GroupID.ValueString()
+	payload.SetPayloadId(&payloadID)
+	payload.SetPayloadType(&payloadType)
+	payload.SetGroupId(&groupID)
+	payload.SetAssignmentFilterType(filterType.(*models.DeviceAndAppManagementAssignmentFilterType))
+
+	payloads := make([]models.AssignmentFilterPayloadable, 0)
+	for _, existing := range assignmentFilter.GetPayloads() {
+		if existing.GetPayloadId() != nil && *existing.GetPayloadId() == payloadID {
+			continue
+		}
+		payloads = append(payloads, existing)
+	}
+	payloads = append(payloads, payload)
+
+	requestBody := models.NewDeviceAndAppManagementAssignmentFilter()
+	requestBody.SetPayloads(payloads)
+
+	_, err = r.client.DeviceManagement().AssignmentFilters().
+		ByDeviceAndAppManagementAssignmentFilterId(data.AssignmentFilterID.ValueString()).Patch(ctx, requestBody, nil)
+	return err
+}
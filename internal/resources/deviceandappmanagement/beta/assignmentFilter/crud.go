@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoftgraph/msgraph-beta-sdk-go/models"
 )
 
 // Create handles the Create operation.
@@ -39,7 +40,7 @@ func (r *AssignmentFilterResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	assignmentFilter, err := r.client.DeviceManagement().AssignmentFilters().Post(ctx, requestBody, nil)
+	assignmentFilter, err := r.createAssignmentFilter(ctx, requestBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating assignment filter",
@@ -50,6 +51,30 @@ func (r *AssignmentFilterResource) Create(ctx context.Context, req resource.Crea
 
 	plan.ID = types.StringValue(*assignmentFilter.GetId())
 
+	err = common.WaitForCreate(ctx, func(ctx context.Context) (*bool, error) {
+		created, err := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(plan.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		if created.GetDisplayName() == nil || *created.GetDisplayName() != plan.DisplayName.ValueString() {
+			return nil, nil
+		}
+
+		done := true
+		return &done, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for assignment filter creation",
+			fmt.Sprintf("Assignment filter %s was created but did not become consistent: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
 	mapRemoteStateToTerraform(ctx, &plan, assignmentFilter)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -85,7 +110,7 @@ func (r *AssignmentFilterResource) Read(ctx context.Context, req resource.ReadRe
 	ctx, cancel := context.WithTimeout(ctx, readTimeout)
 	defer cancel()
 
-	assignmentFilter, err := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(state.ID.ValueString()).Get(ctx, nil)
+	assignmentFilter, err := r.getAssignmentFilter(ctx, state.ID.ValueString())
 	if err != nil {
 		if common.IsNotFoundError(err) {
 			resp.Diagnostics.AddWarning(
@@ -138,7 +163,7 @@ func (r *AssignmentFilterResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	_, err = r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(data.ID.ValueString()).Patch(ctx, requestBody, nil)
+	err = r.updateAssignmentFilter(ctx, data.ID.ValueString(), requestBody)
 	if err != nil {
 		if common.IsNotFoundError(err) && !r.isCreate {
 			resp.Diagnostics.AddWarning(
@@ -155,6 +180,30 @@ func (r *AssignmentFilterResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	err = common.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		updated, err := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(data.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if updated.GetDisplayName() == nil || *updated.GetDisplayName() != data.DisplayName.ValueString() {
+			return nil, nil
+		}
+		if updated.GetRule() == nil || *updated.GetRule() != data.Rule.ValueString() {
+			return nil, nil
+		}
+
+		done := true
+		return &done, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for assignment filter update",
+			fmt.Sprintf("Assignment filter %s was updated but did not become consistent: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished Update of resource: %s_%s", r.ProviderTypeName, r.TypeName))
@@ -179,13 +228,135 @@ func (r *AssignmentFilterResource) Delete(ctx context.Context, req resource.Dele
 	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
-	err := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(data.ID.ValueString()).Delete(ctx, nil)
+	err := r.deleteAssignmentFilter(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
 		return
 	}
 
+	err = common.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		_, err := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(data.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				done := true
+				return &done, nil
+			}
+			return nil, err
+		}
+		return nil, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for assignment filter deletion",
+			fmt.Sprintf("Assignment filter %s was deleted but did not disappear from Graph: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Completed deletion of resource: %s_%s", r.ProviderTypeName, r.TypeName))
 
 	resp.State.RemoveResource(ctx)
 }
+
+// getAssignmentFilter reads a single assignment filter, routing the GET
+// through r.batch when the provider's batch_requests attribute is enabled
+// so that many filters refreshed in the same terraform-plugin-framework
+// walk share a $batch call instead of each firing its own request. Falls
+// back to r.client directly when batching is off.
+func (r *AssignmentFilterResource) getAssignmentFilter(ctx context.Context, id string) (models.AssignmentFilterable, error) {
+	builder := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(id)
+
+	if r.batch == nil {
+		return builder.Get(ctx, nil)
+	}
+
+	info, err := builder.ToGetRequestInformation(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.batch.Submit(ctx, "DeviceManagementConfiguration.Read.All", info, models.CreateAssignmentFilterFromDiscriminatorValue)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentFilter, ok := response.(models.AssignmentFilterable)
+	if !ok {
+		return nil, fmt.Errorf("unexpected batched response type for assignment filter %s: %T", id, response)
+	}
+
+	return assignmentFilter, nil
+}
+
+// createAssignmentFilter creates an assignment filter, routing the POST
+// through r.batch when batching is enabled so creates issued in the same
+// terraform-plugin-framework walk share a $batch call instead of each
+// firing its own request. Falls back to r.client directly when batching is
+// off.
+func (r *AssignmentFilterResource) createAssignmentFilter(ctx context.Context, requestBody models.AssignmentFilterable) (models.AssignmentFilterable, error) {
+	builder := r.client.DeviceManagement().AssignmentFilters()
+
+	if r.batch == nil {
+		return builder.Post(ctx, requestBody, nil)
+	}
+
+	info, err := builder.ToPostRequestInformation(ctx, requestBody, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.batch.Submit(ctx, "DeviceManagementConfiguration.ReadWrite.All", info, models.CreateAssignmentFilterFromDiscriminatorValue)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentFilter, ok := response.(models.AssignmentFilterable)
+	if !ok {
+		return nil, fmt.Errorf("unexpected batched response type for assignment filter create: %T", response)
+	}
+
+	return assignmentFilter, nil
+}
+
+// updateAssignmentFilter patches an assignment filter, routing the PATCH
+// through r.batch when batching is enabled. Falls back to r.client directly
+// when batching is off.
+func (r *AssignmentFilterResource) updateAssignmentFilter(ctx context.Context, id string, requestBody models.AssignmentFilterable) error {
+	builder := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(id)
+
+	if r.batch == nil {
+		_, err := builder.Patch(ctx, requestBody, nil)
+		return err
+	}
+
+	info, err := builder.ToPatchRequestInformation(ctx, requestBody, nil)
+	if err != nil {
+		return err
+	}
+
+	// PATCH's subresponse has no body to deserialize, so no constructor is
+	// passed; sendOne resolves the future without calling GetResponseById.
+	_, err = r.batch.Submit(ctx, "DeviceManagementConfiguration.ReadWrite.All", info, nil)
+	return err
+}
+
+// deleteAssignmentFilter deletes an assignment filter, routing the DELETE
+// through r.batch when batching is enabled. Falls back to r.client directly
+// when batching is off.
+func (r *AssignmentFilterResource) deleteAssignmentFilter(ctx context.Context, id string) error {
+	builder := r.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(id)
+
+	if r.batch == nil {
+		return builder.Delete(ctx, nil)
+	}
+
+	info, err := builder.ToDeleteRequestInformation(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// DELETE's subresponse has no body to deserialize, so no constructor is
+	// passed; sendOne resolves the future without calling GetResponseById.
+	_, err = r.batch.Submit(ctx, "DeviceManagementConfiguration.ReadWrite.All", info, nil)
+	return err
+}
@@ -0,0 +1,259 @@
+package graphBetaAssignmentFilter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	"github.com/microsoftgraph/msgraph-beta-sdk-go/devicemanagement"
+)
+
+var _ datasource.DataSource = &AssignmentFilterDataSource{}
+var _ datasource.DataSourceWithConfigure = &AssignmentFilterDataSource{}
+
+func NewAssignmentFilterDataSource() datasource.DataSource {
+	return &AssignmentFilterDataSource{}
+}
+
+// AssignmentFilterDataSource looks up a single assignment filter by `id`,
+// `display_name`, or `odata_filter`, letting other resources reference
+// filters created outside Terraform without hard-coding GUIDs.
+type AssignmentFilterDataSource struct {
+	client           *msgraphbetasdk.GraphServiceClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+// AssignmentFilterDataSourceModel mirrors AssignmentFilterResourceModel
+// minus write-only/timeout concerns.
+type AssignmentFilterDataSourceModel struct {
+	ID                             types.String `tfsdk:"id"`
+	DisplayName                    types.String `tfsdk:"display_name"`
+	ODataFilter                    types.String `tfsdk:"odata_filter"`
+	Description                    types.String `tfsdk:"description"`
+	Platform                       types.String `tfsdk:"platform"`
+	Rule                           types.String `tfsdk:"rule"`
+	AssignmentFilterManagementType types.String `tfsdk:"assignment_filter_management_type"`
+	CreatedDateTime                types.String `tfsdk:"created_date_time"`
+	LastModifiedDateTime           types.String `tfsdk:"last_modified_date_time"`
+	RoleScopeTags                  types.List   `tfsdk:"role_scope_tags"`
+}
+
+func (d *AssignmentFilterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_beta_device_and_app_management_assignment_filter"
+}
+
+func (d *AssignmentFilterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.BetaClient
+}
+
+func (d *AssignmentFilterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Intune assignment filter by `id`, `display_name`, or `odata_filter`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the assignment filter. Exactly one of `id`, `display_name`, or `odata_filter` must be set.",
+			},
+			"display_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The display name of the assignment filter. Exactly one of `id`, `display_name`, or `odata_filter` must be set.",
+			},
+			"odata_filter": schema.StringAttribute{
+				Optional: true,
+				Description: "An OData `$filter` expression passed through to Graph, e.g. `displayName eq 'Windows filter'`. " +
+					"Must match exactly one assignment filter. Exactly one of `id`, `display_name`, or `odata_filter` must be set.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The optional description of the assignment filter.",
+			},
+			"platform": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Intune device management type (platform) for the assignment filter.",
+			},
+			"rule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Rule definition of the assignment filter.",
+			},
+			"assignment_filter_management_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Indicates filter is applied to either 'devices' or 'apps' management type.",
+			},
+			"created_date_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The creation time of the assignment filter.",
+			},
+			"last_modified_date_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last modified time of the assignment filter.",
+			},
+			"role_scope_tags": schema.ListAttribute{
+				Computed:    true,
+				Description: "Indicates role scope tags assigned for the assignment filter.",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *AssignmentFilterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssignmentFilterDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookups := 0
+	if !data.ID.IsNull() {
+		lookups++
+	}
+	if !data.DisplayName.IsNull() {
+		lookups++
+	}
+	if !data.ODataFilter.IsNull() {
+		lookups++
+	}
+
+	if lookups == 0 {
+		resp.Diagnostics.AddError(
+			"Missing lookup attribute",
+			"Exactly one of `id`, `display_name`, or `odata_filter` must be set to look up an assignment filter.",
+		)
+		return
+	}
+
+	if lookups > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting lookup attributes",
+			"Only one of `id`, `display_name`, or `odata_filter` may be set to look up an assignment filter.",
+		)
+		return
+	}
+
+	if !data.ODataFilter.IsNull() {
+		tflog.Debug(ctx, fmt.Sprintf("Looking up assignment filter by odata_filter: %s", data.ODataFilter.ValueString()))
+
+		filter := data.ODataFilter.ValueString()
+		requestConfig := &devicemanagement.AssignmentFiltersRequestBuilderGetRequestConfiguration{
+			QueryParameters: &devicemanagement.AssignmentFiltersRequestBuilderGetQueryParameters{
+				Filter: &filter,
+			},
+		}
+
+		remoteFilters, err := d.client.DeviceManagement().AssignmentFilters().Get(ctx, requestConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing assignment filters",
+				fmt.Sprintf("Could not list assignment filters matching odata_filter %q: %s", filter, err.Error()),
+			)
+			return
+		}
+
+		matches := remoteFilters.GetValue()
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Assignment filter not found",
+				fmt.Sprintf("No assignment filter matched odata_filter %q.", filter),
+			)
+			return
+		}
+		if len(matches) > 1 {
+			ids := make([]string, 0, len(matches))
+			for _, match := range matches {
+				ids = append(ids, *match.GetId())
+			}
+			resp.Diagnostics.AddError(
+				"Multiple assignment filters matched",
+				fmt.Sprintf("Found %d assignment filters matching odata_filter %q: %v. Refine odata_filter to match exactly one.", len(matches), filter, ids),
+			)
+			return
+		}
+
+		mapAssignmentFilterToDataSourceModel(ctx, &data, matches[0])
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, fmt.Sprintf("Looking up assignment filter by id: %s", data.ID.ValueString()))
+
+		remote, err := d.client.DeviceManagement().AssignmentFilters().ByDeviceAndAppManagementAssignmentFilterId(data.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading assignment filter",
+				fmt.Sprintf("Could not read assignment filter with ID %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		mapAssignmentFilterToDataSourceModel(ctx, &data, remote)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Looking up assignment filter by display_name: %s", data.DisplayName.ValueString()))
+
+	remoteFilters, err := d.client.DeviceManagement().AssignmentFilters().Get(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing assignment filters",
+			fmt.Sprintf("Could not list assignment filters: %s", err.Error()),
+		)
+		return
+	}
+
+	var matches []string
+	var matched bool
+	for _, filter := range remoteFilters.GetValue() {
+		if filter.GetDisplayName() != nil && *filter.GetDisplayName() == data.DisplayName.ValueString() {
+			if matched {
+				matches = append(matches, *filter.GetId())
+				continue
+			}
+			mapAssignmentFilterToDataSourceModel(ctx, &data, filter)
+			matches = append(matches, *filter.GetId())
+			matched = true
+		}
+	}
+
+	if !matched {
+		resp.Diagnostics.AddError(
+			"Assignment filter not found",
+			fmt.Sprintf("No assignment filter with display_name %q was found.", data.DisplayName.ValueString()),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple assignment filters matched",
+			fmt.Sprintf("Found %d assignment filters with display_name %q: %v. Use `id` to disambiguate.", len(matches), data.DisplayName.ValueString(), matches),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
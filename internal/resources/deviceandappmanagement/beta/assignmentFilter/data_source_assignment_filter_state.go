@@ -0,0 +1,123 @@
+package graphBetaAssignmentFilter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	"github.com/microsoftgraph/msgraph-beta-sdk-go/devicemanagement"
+)
+
+var _ datasource.DataSource = &AssignmentFilterStateDataSource{}
+var _ datasource.DataSourceWithConfigure = &AssignmentFilterStateDataSource{}
+
+func NewAssignmentFilterStateDataSource() datasource.DataSource {
+	return &AssignmentFilterStateDataSource{}
+}
+
+// AssignmentFilterStateDataSource evaluates an existing assignment filter
+// against a specific managed device via the Graph `getState` endpoint, so CI
+// can validate that a filter rule matches (or excludes) an expected sample
+// device before rolling it out.
+type AssignmentFilterStateDataSource struct {
+	client           *msgraphbetasdk.GraphServiceClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type AssignmentFilterStateDataSourceModel struct {
+	AssignmentFilterID types.String `tfsdk:"assignment_filter_id"`
+	ManagedDeviceID    types.String `tfsdk:"managed_device_id"`
+	State              types.String `tfsdk:"state"`
+	Matches            types.Bool   `tfsdk:"matches"`
+}
+
+func (d *AssignmentFilterStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_beta_device_and_app_management_assignment_filter_state"
+}
+
+func (d *AssignmentFilterStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.BetaClient
+}
+
+func (d *AssignmentFilterStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates an Intune assignment filter against a specific managed device and reports whether the device matches.",
+		Attributes: map[string]schema.Attribute{
+			"assignment_filter_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the assignment filter to evaluate.",
+			},
+			"managed_device_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the managed device to evaluate the filter against.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The raw evaluation state returned by Graph (e.g. 'included', 'excluded').",
+			},
+			"matches": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the device matches (is included by) the assignment filter.",
+			},
+		},
+	}
+}
+
+func (d *AssignmentFilterStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssignmentFilterStateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Evaluating assignment filter %s against managed device %s", data.AssignmentFilterID.ValueString(), data.ManagedDeviceID.ValueString()))
+
+	managedDeviceID := data.ManagedDeviceID.ValueString()
+	requestConfig := &devicemanagement.AssignmentFiltersItemGetStateRequestBuilderGetRequestConfiguration{
+		QueryParameters: &devicemanagement.AssignmentFiltersItemGetStateRequestBuilderGetQueryParameters{
+			ManagedDeviceId: &managedDeviceID,
+		},
+	}
+
+	result, err := d.client.DeviceManagement().AssignmentFilters().
+		ByDeviceAndAppManagementAssignmentFilterId(data.AssignmentFilterID.ValueString()).
+		GetState().Get(ctx, requestConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error evaluating assignment filter state",
+			fmt.Sprintf("Could not evaluate assignment filter %s against device %s: %s", data.AssignmentFilterID.ValueString(), data.ManagedDeviceID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state := ""
+	if result != nil && result.GetState() != nil {
+		state = *result.GetState()
+	}
+
+	data.State = types.StringValue(state)
+	data.Matches = types.BoolValue(state == "included")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,170 @@
+package graphBetaAssignmentFilter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+)
+
+var _ datasource.DataSource = &AssignmentFiltersDataSource{}
+var _ datasource.DataSourceWithConfigure = &AssignmentFiltersDataSource{}
+
+func NewAssignmentFiltersDataSource() datasource.DataSource {
+	return &AssignmentFiltersDataSource{}
+}
+
+// AssignmentFiltersDataSource lists assignment filters, optionally narrowed
+// by platform, management type, and a client-side substring match against
+// the filter's rule.
+type AssignmentFiltersDataSource struct {
+	client           *msgraphbetasdk.GraphServiceClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type AssignmentFiltersDataSourceModel struct {
+	Platform                       types.String                      `tfsdk:"platform"`
+	AssignmentFilterManagementType types.String                      `tfsdk:"assignment_filter_management_type"`
+	RuleContains                   types.String                      `tfsdk:"rule_contains"`
+	Filters                        []AssignmentFilterDataSourceModel `tfsdk:"filters"`
+}
+
+func (d *AssignmentFiltersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_beta_device_and_app_management_assignment_filters"
+}
+
+func (d *AssignmentFiltersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.BetaClient
+}
+
+func (d *AssignmentFiltersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Intune assignment filters, optionally narrowed by platform, management type, or a substring match against the rule.",
+		Attributes: map[string]schema.Attribute{
+			"platform": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return filters for this platform.",
+			},
+			"assignment_filter_management_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return filters with this management type ('devices' or 'apps').",
+			},
+			"rule_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return filters whose `rule` contains this substring. Applied client-side after the Graph list call.",
+			},
+			"filters": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The assignment filters matching the given criteria.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the assignment filter.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the assignment filter.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The optional description of the assignment filter.",
+						},
+						"platform": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Intune device management type (platform) for the assignment filter.",
+						},
+						"rule": schema.StringAttribute{
+							Computed:    true,
+							Description: "Rule definition of the assignment filter.",
+						},
+						"assignment_filter_management_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Indicates filter is applied to either 'devices' or 'apps' management type.",
+						},
+						"created_date_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "The creation time of the assignment filter.",
+						},
+						"last_modified_date_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last modified time of the assignment filter.",
+						},
+						"role_scope_tags": schema.ListAttribute{
+							Computed:    true,
+							Description: "Indicates role scope tags assigned for the assignment filter.",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AssignmentFiltersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssignmentFiltersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing assignment filters")
+
+	remoteFilters, err := d.client.DeviceManagement().AssignmentFilters().Get(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing assignment filters",
+			fmt.Sprintf("Could not list assignment filters: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Filters = make([]AssignmentFilterDataSourceModel, 0, len(remoteFilters.GetValue()))
+	for _, filter := range remoteFilters.GetValue() {
+		if !data.Platform.IsNull() && filter.GetPlatform() != nil && filter.GetPlatform().String() != data.Platform.ValueString() {
+			continue
+		}
+		if !data.AssignmentFilterManagementType.IsNull() && filter.GetAssignmentFilterManagementType() != nil &&
+			filter.GetAssignmentFilterManagementType().String() != data.AssignmentFilterManagementType.ValueString() {
+			continue
+		}
+		if !data.RuleContains.IsNull() {
+			rule := ""
+			if filter.GetRule() != nil {
+				rule = *filter.GetRule()
+			}
+			if !strings.Contains(rule, data.RuleContains.ValueString()) {
+				continue
+			}
+		}
+
+		var item AssignmentFilterDataSourceModel
+		mapAssignmentFilterToDataSourceModel(ctx, &item, filter)
+		data.Filters = append(data.Filters, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
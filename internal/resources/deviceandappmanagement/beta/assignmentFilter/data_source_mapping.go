@@ -0,0 +1,55 @@
+package graphBetaAssignmentFilter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	graphmodels "github.com/microsoftgraph/msgraph-beta-sdk-go/models"
+)
+
+// mapAssignmentFilterToDataSourceModel copies the remote assignment filter
+// fields into the shared data source model shape used by both the singular
+// and plural data sources.
+func mapAssignmentFilterToDataSourceModel(ctx context.Context, data *AssignmentFilterDataSourceModel, remote graphmodels.DeviceAndAppManagementAssignmentFilterable) {
+	if remote == nil {
+		return
+	}
+
+	data.ID = types.StringPointerValue(remote.GetId())
+	data.DisplayName = types.StringPointerValue(remote.GetDisplayName())
+	data.Description = types.StringPointerValue(remote.GetDescription())
+	data.Rule = types.StringPointerValue(remote.GetRule())
+
+	if platform := remote.GetPlatform(); platform != nil {
+		data.Platform = types.StringValue(platform.String())
+	} else {
+		data.Platform = types.StringNull()
+	}
+
+	if managementType := remote.GetAssignmentFilterManagementType(); managementType != nil {
+		data.AssignmentFilterManagementType = types.StringValue(managementType.String())
+	} else {
+		data.AssignmentFilterManagementType = types.StringNull()
+	}
+
+	if created := remote.GetCreatedDateTime(); created != nil {
+		data.CreatedDateTime = types.StringValue(created.String())
+	} else {
+		data.CreatedDateTime = types.StringNull()
+	}
+
+	if modified := remote.GetLastModifiedDateTime(); modified != nil {
+		data.LastModifiedDateTime = types.StringValue(modified.String())
+	} else {
+		data.LastModifiedDateTime = types.StringNull()
+	}
+
+	tags := remote.GetRoleScopeTags()
+	elements := make([]types.String, 0, len(tags))
+	for _, tag := range tags {
+		elements = append(elements, types.StringValue(tag))
+	}
+	listValue, _ := types.ListValueFrom(ctx, types.StringType, elements)
+	data.RoleScopeTags = listValue
+}
@@ -0,0 +1,35 @@
+package graphBetaAssignmentFilter
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Registration implements provider.ServiceRegistration for the Intune
+// assignment filter subsystem.
+type Registration struct{}
+
+// Name returns the stable identifier used for registration ordering and
+// duplicate detection.
+func (Registration) Name() string {
+	return "device_and_app_management/assignment_filter"
+}
+
+// Resources returns the assignment filter and assignment filter assignment
+// resources.
+func (Registration) Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewAssignmentFilterResource,
+		NewAssignmentFilterAssignmentResource,
+	}
+}
+
+// DataSources returns the assignment filter lookup, list, and state data
+// sources.
+func (Registration) DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewAssignmentFilterDataSource,
+		NewAssignmentFiltersDataSource,
+		NewAssignmentFilterStateDataSource,
+	}
+}
@@ -28,6 +28,7 @@ func NewAssignmentFilterResource() resource.Resource {
 
 type AssignmentFilterResource struct {
 	client           *msgraphbetasdk.GraphServiceClient
+	batch            *client.BatchExecutor
 	ProviderTypeName string
 	TypeName         string
 }
@@ -81,6 +82,7 @@ func (r *AssignmentFilterResource) Configure(ctx context.Context, req resource.C
 	}
 
 	r.client = clients.BetaClient
+	r.batch = clients.BetaBatch
 	tflog.Debug(ctx, "Initialized graphBetaAssignmentFilter resource with BetaClient")
 }
 
@@ -113,8 +115,13 @@ func (r *AssignmentFilterResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"rule": schema.StringAttribute{
-				Required:    true,
-				Description: "Rule definition of the assignment filter.",
+				Required: true,
+				Description: "Rule definition of the assignment filter, written in the Intune assignment filter rule grammar " +
+					"(e.g. `device.deviceName -startsWith \"CORP-\"`). Validated at plan time against the property whitelist " +
+					"for the selected platform.",
+				Validators: []validator.String{
+					validateRule(),
+				},
 			},
 			"assignment_filter_management_type": schema.StringAttribute{
 				Optional:    true,
@@ -138,28 +145,28 @@ func (r *AssignmentFilterResource) Schema(ctx context.Context, req resource.Sche
 				ElementType: types.StringType,
 			},
 			"payloads": schema.ListNestedAttribute{
-				Optional:    true,
-				Description: "Indicates associated assignments for a specific filter.",
+				Computed: true,
+				Description: "Policy or app assignments bound to this filter. Computed here because it's a shared collection " +
+					"mutated by every binding of this filter - manage individual entries with the " +
+					"graph_beta_device_and_app_management_assignment_filter_assignment resource instead of editing this list " +
+					"directly.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"payload_id": schema.StringAttribute{
-							Required:    true,
+							Computed:    true,
 							Description: "The ID of the payload.",
 						},
 						"payload_type": schema.StringAttribute{
-							Required:    true,
+							Computed:    true,
 							Description: "The type of the payload.",
 						},
 						"group_id": schema.StringAttribute{
-							Required:    true,
+							Computed:    true,
 							Description: "The group ID associated with the payload.",
 						},
 						"assignment_filter_type": schema.StringAttribute{
-							Required:    true,
+							Computed:    true,
 							Description: fmt.Sprintf("The assignment filter type. Supported types: %v", getAllAssignmentFilterTypes()),
-							Validators: []validator.String{
-								assignmentFilterTypeValidator{},
-							},
 						},
 					},
 				},
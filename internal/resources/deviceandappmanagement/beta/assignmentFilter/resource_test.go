@@ -0,0 +1,134 @@
+package graphBetaAssignmentFilter_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/testing/mockgraph"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const resourceAddr = "microsoft365_graph_beta_device_and_app_management_assignment_filter.test"
+
+func filterConfig(mock *mockgraph.Server, displayName, rule string) string {
+	return testprovider.ConfigHeader(mock) + fmt.Sprintf(`
+resource "microsoft365_graph_beta_device_and_app_management_assignment_filter" "test" {
+  display_name = %[1]q
+  platform     = "windows10AndLater"
+  rule         = %[2]q
+}
+`, displayName, rule)
+}
+
+// TestAccAssignmentFilterResource_CreateUpdateDelete exercises the basic
+// create -> update -> destroy lifecycle against a mock Graph server.
+func TestAccAssignmentFilterResource_CreateUpdateDelete(t *testing.T) {
+	mock := mockgraph.NewServer()
+	defer mock.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testprovider.ProtoV6ProviderFactories(mock),
+		Steps: []resource.TestStep{
+			{
+				Config: filterConfig(mock, "acc-test-filter", `device.manufacturer -eq "Microsoft"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddr, "display_name", "acc-test-filter"),
+					resource.TestCheckResourceAttr(resourceAddr, "rule", `device.manufacturer -eq "Microsoft"`),
+					resource.TestCheckResourceAttrSet(resourceAddr, "id"),
+				),
+			},
+			{
+				Config: filterConfig(mock, "acc-test-filter-renamed", `device.manufacturer -eq "Microsoft"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddr, "display_name", "acc-test-filter-renamed"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAssignmentFilterResource_Import verifies that an existing filter can
+// be imported by ID.
+func TestAccAssignmentFilterResource_Import(t *testing.T) {
+	mock := mockgraph.NewServer()
+	defer mock.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testprovider.ProtoV6ProviderFactories(mock),
+		Steps: []resource.TestStep{
+			{
+				Config: filterConfig(mock, "acc-test-import", `device.manufacturer -eq "Microsoft"`),
+			},
+			{
+				ResourceName:      resourceAddr,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccAssignmentFilterResource_Drift verifies that a rule mutated outside
+// of Terraform (simulating a manual Graph/Intune console edit) is detected
+// as drift on the next plan and reconciled on apply.
+func TestAccAssignmentFilterResource_Drift(t *testing.T) {
+	mock := mockgraph.NewServer()
+	defer mock.Close()
+
+	rule := `device.manufacturer -eq "Microsoft"`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testprovider.ProtoV6ProviderFactories(mock),
+		Steps: []resource.TestStep{
+			{
+				Config: filterConfig(mock, "acc-test-drift", rule),
+				Check:  resource.TestCheckResourceAttr(resourceAddr, "rule", rule),
+			},
+			{
+				PreConfig: func() {
+					mock.MutateRule("1", `device.manufacturer -eq "Contoso"`)
+				},
+				Config:             filterConfig(mock, "acc-test-drift", rule),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestAccAssignmentFilterResource_RetriesOnThrottling verifies that the
+// provider's retry transport recovers from a single injected 429 response.
+func TestAccAssignmentFilterResource_RetriesOnThrottling(t *testing.T) {
+	mock := mockgraph.NewServer()
+	defer mock.Close()
+	mock.FailNext(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testprovider.ProtoV6ProviderFactories(mock),
+		Steps: []resource.TestStep{
+			{
+				Config: filterConfig(mock, "acc-test-retry", `device.manufacturer -eq "Microsoft"`),
+				Check:  resource.TestCheckResourceAttrSet(resourceAddr, "id"),
+			},
+		},
+	})
+}
+
+// TestAccAssignmentFilterResource_InvalidRule verifies that a rule
+// referencing a property unsupported on the selected platform is rejected at
+// plan time, before any request reaches Graph.
+func TestAccAssignmentFilterResource_InvalidRule(t *testing.T) {
+	mock := mockgraph.NewServer()
+	defer mock.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testprovider.ProtoV6ProviderFactories(mock),
+		Steps: []resource.TestStep{
+			{
+				Config:      filterConfig(mock, "acc-test-invalid-rule", `device.notAProperty -eq "x"`),
+				ExpectError: regexp.MustCompile("Invalid assignment filter rule"),
+			},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package graphBetaAssignmentFilter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/services/deviceandappmanagement/assignmentfilter/rulelang"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ruleValidator parses the `rule` attribute against the Intune assignment
+// filter rule grammar and, once the `platform` and
+// `assignment_filter_management_type` values are known, enforces the
+// platform property whitelist and the apps/device-only property rule.
+type ruleValidator struct{}
+
+func (v ruleValidator) Description(ctx context.Context) string {
+	return "rule must be a syntactically valid Intune assignment filter rule for the configured platform"
+}
+
+func (v ruleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ruleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	rule := req.ConfigValue.ValueString()
+	if rule == "" {
+		return
+	}
+
+	var platform types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("platform"), &platform)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managementType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("assignment_filter_management_type"), &managementType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if platform.IsUnknown() || managementType.IsUnknown() {
+		return
+	}
+
+	opts := rulelang.ValidateOptions{
+		Platform:       platform.ValueString(),
+		ManagementType: managementType.ValueString(),
+	}
+	if opts.ManagementType == "" {
+		opts.ManagementType = "devices"
+	}
+
+	if err := rulelang.Validate(rule, opts); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid assignment filter rule",
+			fmt.Sprintf("Could not parse rule: %s", err.Error()),
+		)
+	}
+}
+
+func validateRule() validator.String {
+	return ruleValidator{}
+}
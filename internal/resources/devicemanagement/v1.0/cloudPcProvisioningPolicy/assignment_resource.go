@@ -0,0 +1,395 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	"github.com/microsoftgraph/msgraph-sdk-go/devicemanagement"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+)
+
+var _ resource.Resource = &CloudPcProvisioningPolicyAssignmentResource{}
+var _ resource.ResourceWithConfigure = &CloudPcProvisioningPolicyAssignmentResource{}
+var _ resource.ResourceWithImportState = &CloudPcProvisioningPolicyAssignmentResource{}
+
+func NewCloudPcProvisioningPolicyAssignmentResource() resource.Resource {
+	return &CloudPcProvisioningPolicyAssignmentResource{}
+}
+
+// CloudPcProvisioningPolicyAssignmentResource manages the whole assignment
+// set of a provisioning policy as a single resource, mirroring the Graph
+// `assign` action it wraps: every apply sends the complete desired list of
+// group targets, and Graph replaces whatever was assigned before rather than
+// merging, so partial/nested management of individual assignments isn't
+// possible here.
+type CloudPcProvisioningPolicyAssignmentResource struct {
+	client           *msgraphsdk.GraphServiceClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type CloudPcProvisioningPolicyAssignmentResourceModel struct {
+	ID                   types.String             `tfsdk:"id"`
+	ProvisioningPolicyID types.String             `tfsdk:"provisioning_policy_id"`
+	Assignments          []CloudPcAssignmentModel `tfsdk:"assignments"`
+	Timeouts             timeouts.Value           `tfsdk:"timeouts"`
+}
+
+type CloudPcAssignmentModel struct {
+	GroupID                types.String `tfsdk:"group_id"`
+	ServicePlanID          types.String `tfsdk:"service_plan_id"`
+	AllotmentDisplayName   types.String `tfsdk:"allotment_display_name"`
+	AllotmentLicensesCount types.Int64  `tfsdk:"allotment_licenses_count"`
+}
+
+// Metadata returns the resource type name.
+func (r *CloudPcProvisioningPolicyAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_cloud_pc_provisioning_policy_assignment"
+}
+
+// Configure sets the client for the resource.
+func (r *CloudPcProvisioningPolicyAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Configuring CloudPcProvisioningPolicyAssignmentResource")
+
+	if req.ProviderData == nil {
+		tflog.Warn(ctx, "Provider data is nil, skipping resource configuration")
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if clients.StableClient == nil {
+		tflog.Warn(ctx, "StableClient is nil, resource may not be fully configured")
+		return
+	}
+
+	r.client = clients.StableClient
+}
+
+// ImportState imports the resource using the provisioning policy ID.
+func (r *CloudPcProvisioningPolicyAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("provisioning_policy_id"), req, resp)
+}
+
+// Schema returns the schema for the resource.
+func (r *CloudPcProvisioningPolicyAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the group assignments of a `microsoft365_graph_cloud_pc_provisioning_policy` via " +
+			"Graph's `assign` action. Every apply sends the complete desired assignment list; Graph replaces the " +
+			"previous set rather than merging into it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of this resource, which is the provisioning policy's ID.",
+			},
+			"provisioning_policy_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Cloud PC provisioning policy to assign.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignments": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The complete set of group targets the provisioning policy is assigned to.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The Entra ID group the provisioning policy is assigned to.",
+						},
+						"service_plan_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The service plan to assign in mixed-SKU tenants. Leave unset to assign the default service plan.",
+						},
+						"allotment_display_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "The display name of the shared-use allotment to create for this group. Only applicable to shared provisioning policies.",
+						},
+						"allotment_licenses_count": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The number of licenses to allot to this group's shared-use allotment. Only applicable to shared provisioning policies.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create handles the Create operation.
+func (r *CloudPcProvisioningPolicyAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CloudPcProvisioningPolicyAssignmentResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.assign(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error assigning cloud PC provisioning policy",
+			fmt.Sprintf("Could not assign provisioning policy %s: %s", plan.ProvisioningPolicyID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = plan.ProvisioningPolicyID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+}
+
+// Read handles the Read operation.
+func (r *CloudPcProvisioningPolicyAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CloudPcProvisioningPolicyAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	assignments := make([]CloudPcAssignmentModel, 0)
+
+	result, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().
+		ByCloudPcProvisioningPolicyId(state.ProvisioningPolicyID.ValueString()).Assignments().Get(ctx, nil)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Cloud PC provisioning policy not found",
+				fmt.Sprintf("Provisioning policy with ID %s was not found. Removing assignment from state.", state.ProvisioningPolicyID.ValueString()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading cloud PC provisioning policy assignments",
+			fmt.Sprintf("Could not read assignments for provisioning policy %s: %s", state.ProvisioningPolicyID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Assignments() only returns a single page; policies assigned to more
+	// groups than fit on one page would otherwise have their tail silently
+	// dropped from state. PageIterator follows @odata.nextLink until
+	// exhausted.
+	pageIterator, err := msgraphcore.NewPageIterator[models.CloudPcProvisioningPolicyAssignmentable](
+		result, r.client.GetAdapter(), models.CreateCloudPcProvisioningPolicyAssignmentCollectionResponseFromDiscriminatorValue,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error paging cloud PC provisioning policy assignments",
+			fmt.Sprintf("Could not construct a page iterator for provisioning policy %s: %s", state.ProvisioningPolicyID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	err = pageIterator.Iterate(ctx, func(assignment models.CloudPcProvisioningPolicyAssignmentable) bool {
+		target, ok := assignment.GetTarget().(models.CloudPcManagementGroupAssignmentTargetable)
+		if !ok || target == nil {
+			return true
+		}
+
+		assignments = append(assignments, CloudPcAssignmentModel{
+			GroupID:                types.StringPointerValue(target.GetGroupId()),
+			ServicePlanID:          types.StringPointerValue(target.GetServicePlanId()),
+			AllotmentDisplayName:   types.StringPointerValue(target.GetAllotmentDisplayName()),
+			AllotmentLicensesCount: types.Int64PointerValue(target.GetAllotmentLicensesCount()),
+		})
+
+		return true
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading cloud PC provisioning policy assignments",
+			fmt.Sprintf("Could not page through assignments for provisioning policy %s: %s", state.ProvisioningPolicyID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if len(assignments) == 0 {
+		resp.Diagnostics.AddWarning(
+			"Cloud PC provisioning policy assignment not found",
+			fmt.Sprintf("Provisioning policy %s has no assignments. Removing from state.", state.ProvisioningPolicyID.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Graph returns assignments in an arbitrary, not-config-stable order, so
+	// storing them as-is produces a perpetual diff against the config's
+	// order. Reuse prior state's ordering where the group is still present,
+	// and append any groups new to state in a deterministic (sorted) order.
+	state.Assignments = orderAssignments(state.Assignments, assignments)
+	state.ID = state.ProvisioningPolicyID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update handles the Update operation.
+func (r *CloudPcProvisioningPolicyAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CloudPcProvisioningPolicyAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if err := r.assign(ctx, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating cloud PC provisioning policy assignment",
+			fmt.Sprintf("Could not reassign provisioning policy %s: %s", data.ProvisioningPolicyID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete handles the Delete operation by sending an empty assignment set.
+func (r *CloudPcProvisioningPolicyAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CloudPcProvisioningPolicyAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	requestBody := devicemanagement.NewProvisioningPoliciesItemAssignPostRequestBody()
+	requestBody.SetAssignments(make([]models.CloudPcProvisioningPolicyAssignmentable, 0))
+
+	err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().
+		ByCloudPcProvisioningPolicyId(data.ProvisioningPolicyID.ValueString()).Assign().Post(ctx, requestBody, nil)
+	if err != nil {
+		if !common.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting cloud PC provisioning policy assignment",
+				fmt.Sprintf("Could not clear assignments for provisioning policy %s: %s", data.ProvisioningPolicyID.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// assign sends data's complete assignment set to Graph's `assign` action,
+// which replaces whatever was assigned before.
+func (r *CloudPcProvisioningPolicyAssignmentResource) assign(ctx context.Context, data *CloudPcProvisioningPolicyAssignmentResourceModel) error {
+	assignments := make([]models.CloudPcProvisioningPolicyAssignmentable, 0, len(data.Assignments))
+	for _, planned := range data.Assignments {
+		target := models.NewCloudPcManagementGroupAssignmentTarget()
+		target.SetGroupId(planned.GroupID.ValueStringPointer())
+		target.SetServicePlanId(planned.ServicePlanID.ValueStringPointer())
+		target.SetAllotmentDisplayName(planned.AllotmentDisplayName.ValueStringPointer())
+		target.SetAllotmentLicensesCount(planned.AllotmentLicensesCount.ValueInt64Pointer())
+
+		assignment := models.NewCloudPcProvisioningPolicyAssignment()
+		assignment.SetTarget(target)
+		assignments = append(assignments, assignment)
+	}
+
+	requestBody := devicemanagement.NewProvisioningPoliciesItemAssignPostRequestBody()
+	requestBody.SetAssignments(assignments)
+
+	return r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().
+		ByCloudPcProvisioningPolicyId(data.ProvisioningPolicyID.ValueString()).Assign().Post(ctx, requestBody, nil)
+}
+
+// orderAssignments reorders remote's Graph-returned assignments (in
+// arbitrary order) to match prior's existing order where a group is present
+// in both, so Read doesn't produce a diff against config purely from
+// reordering. Groups new to state (e.g. on import, or added outside
+// Terraform) are appended afterwards in a deterministic order so repeated
+// Reads are stable.
+func orderAssignments(prior, remote []CloudPcAssignmentModel) []CloudPcAssignmentModel {
+	remoteByGroup := make(map[string]CloudPcAssignmentModel, len(remote))
+	for _, assignment := range remote {
+		remoteByGroup[assignment.GroupID.ValueString()] = assignment
+	}
+
+	ordered := make([]CloudPcAssignmentModel, 0, len(remote))
+	seen := make(map[string]bool, len(remote))
+	for _, existing := range prior {
+		groupID := existing.GroupID.ValueString()
+		if assignment, ok := remoteByGroup[groupID]; ok && !seen[groupID] {
+			ordered = append(ordered, assignment)
+			seen[groupID] = true
+		}
+	}
+
+	remainder := make([]CloudPcAssignmentModel, 0)
+	for _, assignment := range remote {
+		if !seen[assignment.GroupID.ValueString()] {
+			remainder = append(remainder, assignment)
+		}
+	}
+	sort.Slice(remainder, func(i, j int) bool {
+		return remainder[i].GroupID.ValueString() < remainder[j].GroupID.ValueString()
+	})
+
+	return append(ordered, remainder...)
+}
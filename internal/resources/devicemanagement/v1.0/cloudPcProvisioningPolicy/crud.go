@@ -0,0 +1,356 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+)
+
+// constructResource builds the Graph request body for a Create or Update
+// call from the Terraform plan.
+func constructResource(data *CloudPcProvisioningPolicyResourceModel) *models.CloudPcProvisioningPolicy {
+	requestBody := models.NewCloudPcProvisioningPolicy()
+
+	requestBody.SetDisplayName(data.DisplayName.ValueStringPointer())
+	requestBody.SetDescription(data.Description.ValueStringPointer())
+	requestBody.SetCloudPcNamingTemplate(data.CloudPcNamingTemplate.ValueStringPointer())
+	requestBody.SetImageId(data.ImageId.ValueStringPointer())
+	requestBody.SetEnableSingleSignOn(data.EnableSingleSignOn.ValueBoolPointer())
+	requestBody.SetLocalAdminEnabled(data.LocalAdminEnabled.ValueBoolPointer())
+
+	if imageType, err := models.ParseCloudPcProvisioningPolicyImageType(data.ImageType.ValueString()); err == nil && imageType != nil {
+		value := imageType.(models.CloudPcProvisioningPolicyImageType)
+		requestBody.SetImageType(&value)
+	}
+
+	if provisioningType, err := models.ParseCloudPcProvisioningType(data.ProvisioningType.ValueString()); err == nil && provisioningType != nil {
+		value := provisioningType.(models.CloudPcProvisioningType)
+		requestBody.SetProvisioningType(&value)
+	}
+
+	if len(data.DomainJoinConfigurations) > 0 {
+		configurations := make([]models.CloudPcDomainJoinConfigurationable, 0, len(data.DomainJoinConfigurations))
+		for _, configuration := range data.DomainJoinConfigurations {
+			domainJoinConfiguration := models.NewCloudPcDomainJoinConfiguration()
+			domainJoinConfiguration.SetOnPremisesConnectionId(configuration.OnPremisesConnectionId.ValueStringPointer())
+			domainJoinConfiguration.SetRegionName(configuration.RegionName.ValueStringPointer())
+			if domainJoinType, err := models.ParseCloudPcDomainJoinType(configuration.DomainJoinType.ValueString()); err == nil && domainJoinType != nil {
+				value := domainJoinType.(models.CloudPcDomainJoinType)
+				domainJoinConfiguration.SetDomainJoinType(&value)
+			}
+			configurations = append(configurations, domainJoinConfiguration)
+		}
+		requestBody.SetDomainJoinConfigurations(configurations)
+	}
+
+	if data.MicrosoftManagedDesktop != nil {
+		microsoftManagedDesktop := models.NewMicrosoftManagedDesktop()
+		microsoftManagedDesktop.SetProfile(data.MicrosoftManagedDesktop.Profile.ValueStringPointer())
+		if managedType, err := models.ParseCloudPcManagementService(data.MicrosoftManagedDesktop.ManagedType.ValueString()); err == nil && managedType != nil {
+			value := managedType.(models.CloudPcManagementService)
+			microsoftManagedDesktop.SetManagedType(&value)
+		}
+		requestBody.SetMicrosoftManagedDesktop(microsoftManagedDesktop)
+	}
+
+	if data.WindowsSetting != nil {
+		windowsSetting := models.NewCloudPcWindowsSetting()
+		windowsSetting.SetLocale(data.WindowsSetting.Locale.ValueStringPointer())
+		requestBody.SetWindowsSetting(windowsSetting)
+	}
+
+	return requestBody
+}
+
+// mapRemoteStateToTerraform copies the fields Graph returns that are
+// Computed-only in the schema back onto the model; the rest of the model
+// already reflects the plan the caller just applied.
+func mapRemoteStateToTerraform(data *CloudPcProvisioningPolicyResourceModel, remote models.CloudPcProvisioningPolicyable) {
+	data.ID = types.StringValue(*remote.GetId())
+	data.AlternateResourceUrl = types.StringPointerValue(remote.GetAlternateResourceUrl())
+	data.CloudPcGroupDisplayName = types.StringPointerValue(remote.GetCloudPcGroupDisplayName())
+	data.GracePeriodInHours = types.Int64PointerValue(remote.GetGracePeriodInHours())
+	data.ImageDisplayName = types.StringPointerValue(remote.GetImageDisplayName())
+}
+
+// Create handles the Create operation.
+func (r *CloudPcProvisioningPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CloudPcProvisioningPolicyResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultProvisioningCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	requestBody := constructResource(&plan)
+
+	provisioningPolicy, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().Post(ctx, requestBody, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cloud PC provisioning policy",
+			fmt.Sprintf("Could not create cloud PC provisioning policy: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(*provisioningPolicy.GetId())
+
+	err = common.WaitForCreate(ctx, func(ctx context.Context) (*bool, error) {
+		created, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(plan.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		if created.GetDisplayName() == nil || *created.GetDisplayName() != plan.DisplayName.ValueString() {
+			return nil, nil
+		}
+
+		done := true
+		return &done, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for cloud PC provisioning policy creation",
+			fmt.Sprintf("Cloud PC provisioning policy %s was created but did not become consistent: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mapRemoteStateToTerraform(&plan, provisioningPolicy)
+
+	if plan.SkipWaitForProvisioning.ValueBool() {
+		plan.LastProvisioningStatus = types.StringNull()
+		plan.LastProvisioningError = types.StringNull()
+	} else {
+		status, provisioningErr, waitErr := r.waitForProvisioningRollout(ctx, plan.ID.ValueString(), createTimeout)
+		plan.LastProvisioningStatus = types.StringValue(status)
+		plan.LastProvisioningError = types.StringValue(provisioningErr)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		if waitErr != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for cloud PC provisioning to complete",
+				fmt.Sprintf("Provisioning policy %s was created but its Cloud PCs did not reach a provisioned state: %s", plan.ID.ValueString(), waitErr.Error()),
+			)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Finished creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+}
+
+// Read handles the Read operation.
+func (r *CloudPcProvisioningPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CloudPcProvisioningPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provisioningPolicy, err := r.getProvisioningPolicy(ctx, state.ID.ValueString())
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Cloud PC provisioning policy not found",
+				fmt.Sprintf("Cloud PC provisioning policy with ID %s was not found. Removing from state.", state.ID.ValueString()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading cloud PC provisioning policy",
+			fmt.Sprintf("Could not read cloud PC provisioning policy with ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mapRemoteStateToTerraform(&state, provisioningPolicy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getProvisioningPolicy reads a single provisioning policy, routing the GET
+// through r.batch when the provider's batch_requests attribute is enabled
+// so that many policies refreshed in the same terraform-plugin-framework
+// walk share a $batch call instead of each firing its own request. Falls
+// back to r.client directly when batching is off.
+func (r *CloudPcProvisioningPolicyResource) getProvisioningPolicy(ctx context.Context, id string) (models.CloudPcProvisioningPolicyable, error) {
+	builder := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(id)
+
+	if r.batch == nil {
+		return builder.Get(ctx, nil)
+	}
+
+	info, err := builder.ToGetRequestInformation(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.batch.Submit(ctx, "CloudPC.Read.All", info, models.CreateCloudPcProvisioningPolicyFromDiscriminatorValue)
+	if err != nil {
+		return nil, err
+	}
+
+	provisioningPolicy, ok := response.(models.CloudPcProvisioningPolicyable)
+	if !ok {
+		return nil, fmt.Errorf("unexpected batched response type for provisioning policy %s: %T", id, response)
+	}
+
+	return provisioningPolicy, nil
+}
+
+// Update handles the Update operation.
+func (r *CloudPcProvisioningPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CloudPcProvisioningPolicyResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting Update of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultProvisioningUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	requestBody := constructResource(&data)
+
+	_, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(data.ID.ValueString()).Patch(ctx, requestBody, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating cloud PC provisioning policy",
+			fmt.Sprintf("Could not update resource: %s_%s: %s", r.ProviderTypeName, r.TypeName, err.Error()),
+		)
+		return
+	}
+
+	err = common.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		updated, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(data.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if updated.GetDisplayName() == nil || *updated.GetDisplayName() != data.DisplayName.ValueString() {
+			return nil, nil
+		}
+
+		done := true
+		return &done, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for cloud PC provisioning policy update",
+			fmt.Sprintf("Cloud PC provisioning policy %s was updated but did not become consistent: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if data.SkipWaitForProvisioning.ValueBool() {
+		data.LastProvisioningStatus = types.StringNull()
+		data.LastProvisioningError = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		tflog.Debug(ctx, fmt.Sprintf("Finished Update of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+		return
+	}
+
+	status, provisioningErr, waitErr := r.waitForProvisioningRollout(ctx, data.ID.ValueString(), updateTimeout)
+	data.LastProvisioningStatus = types.StringValue(status)
+	data.LastProvisioningError = types.StringValue(provisioningErr)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if waitErr != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for cloud PC provisioning to complete",
+			fmt.Sprintf("Provisioning policy %s was updated but its Cloud PCs did not reach a provisioned state: %s", data.ID.ValueString(), waitErr.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished Update of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+}
+
+// Delete handles the Delete operation.
+func (r *CloudPcProvisioningPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CloudPcProvisioningPolicyResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting deletion of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(data.ID.ValueString()).Delete(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	err = common.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		_, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(data.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				done := true
+				return &done, nil
+			}
+			return nil, err
+		}
+		return nil, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for cloud PC provisioning policy deletion",
+			fmt.Sprintf("Cloud PC provisioning policy %s was deleted but did not disappear from Graph: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Completed deletion of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.State.RemoveResource(ctx)
+}
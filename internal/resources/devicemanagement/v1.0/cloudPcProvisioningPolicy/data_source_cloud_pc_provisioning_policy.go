@@ -0,0 +1,267 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/devicemanagement"
+)
+
+var _ datasource.DataSource = &CloudPcProvisioningPolicyDataSource{}
+var _ datasource.DataSourceWithConfigure = &CloudPcProvisioningPolicyDataSource{}
+
+func NewCloudPcProvisioningPolicyDataSource() datasource.DataSource {
+	return &CloudPcProvisioningPolicyDataSource{}
+}
+
+// CloudPcProvisioningPolicyDataSource looks up a single Cloud PC
+// provisioning policy by `id` or `odata_filter`, letting other resources
+// reference policies created outside Terraform without hard-coding GUIDs.
+type CloudPcProvisioningPolicyDataSource struct {
+	client           *msgraphsdk.GraphServiceClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+// CloudPcProvisioningPolicyDataSourceModel mirrors
+// CloudPcProvisioningPolicyResourceModel minus write-only/timeout concerns.
+type CloudPcProvisioningPolicyDataSourceModel struct {
+	ID                       types.String                   `tfsdk:"id"`
+	ODataFilter              types.String                   `tfsdk:"odata_filter"`
+	AlternateResourceUrl     types.String                   `tfsdk:"alternate_resource_url"`
+	CloudPcGroupDisplayName  types.String                   `tfsdk:"cloud_pc_group_display_name"`
+	CloudPcNamingTemplate    types.String                   `tfsdk:"cloud_pc_naming_template"`
+	Description              types.String                   `tfsdk:"description"`
+	DisplayName              types.String                   `tfsdk:"display_name"`
+	DomainJoinConfigurations []DomainJoinConfigurationModel `tfsdk:"domain_join_configurations"`
+	EnableSingleSignOn       types.Bool                     `tfsdk:"enable_single_sign_on"`
+	GracePeriodInHours       types.Int64                    `tfsdk:"grace_period_in_hours"`
+	ImageDisplayName         types.String                   `tfsdk:"image_display_name"`
+	ImageId                  types.String                   `tfsdk:"image_id"`
+	ImageType                types.String                   `tfsdk:"image_type"`
+	LocalAdminEnabled        types.Bool                     `tfsdk:"local_admin_enabled"`
+	MicrosoftManagedDesktop  *MicrosoftManagedDesktopModel  `tfsdk:"microsoft_managed_desktop"`
+	ProvisioningType         types.String                   `tfsdk:"provisioning_type"`
+	WindowsSetting           *WindowsSettingModel           `tfsdk:"windows_setting"`
+}
+
+func (d *CloudPcProvisioningPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_cloud_pc_provisioning_policy"
+}
+
+func (d *CloudPcProvisioningPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.StableClient
+}
+
+func (d *CloudPcProvisioningPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Cloud PC provisioning policy by `id` or `odata_filter`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier of the provisioning policy. Exactly one of `id` or `odata_filter` must be set.",
+			},
+			"odata_filter": schema.StringAttribute{
+				Optional: true,
+				Description: "An OData `$filter` expression passed through to Graph, e.g. `displayName eq 'Finance Cloud PCs'`. " +
+					"Must match exactly one provisioning policy. Exactly one of `id` or `odata_filter` must be set.",
+			},
+			"alternate_resource_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the alternate resource that links to this provisioning policy. Read-only.",
+			},
+			"cloud_pc_group_display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The display name of the Cloud PC group that the Cloud PCs reside in. Read-only.",
+			},
+			"cloud_pc_naming_template": schema.StringAttribute{
+				Computed:    true,
+				Description: "The template used to name Cloud PCs provisioned using this policy.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The provisioning policy description.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The display name for the provisioning policy.",
+			},
+			"domain_join_configurations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Specifies a list ordered by priority on how Cloud PCs join Microsoft Entra ID (Azure AD).",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain_join_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Specifies the method by which the provisioned Cloud PC joins Microsoft Entra ID.",
+						},
+						"on_premises_connection_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Azure network connection ID that matches the virtual network IT admins want the provisioning policy to use when they create Cloud PCs.",
+						},
+						"region_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The supported Azure region where the IT admin wants the provisioning policy to create Cloud PCs.",
+						},
+					},
+				},
+			},
+			"enable_single_sign_on": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the provisioned Cloud PC can be accessed by single sign-on.",
+			},
+			"grace_period_in_hours": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of hours to wait before reprovisioning/deprovisioning happens. Read-only.",
+			},
+			"image_display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The display name of the operating system image that is used for provisioning.",
+			},
+			"image_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier that represents an operating system image used for provisioning new Cloud PCs.",
+			},
+			"image_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of operating system image (custom or gallery) that is used for provisioning on Cloud PCs.",
+			},
+			"local_admin_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "When true, the local admin is enabled for Cloud PCs.",
+			},
+			"microsoft_managed_desktop": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The specific settings for Microsoft Managed Desktop that enables Microsoft Managed Desktop customers to get device managed experience for Cloud PC.",
+				Attributes: map[string]schema.Attribute{
+					"managed_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Indicates the provisioning policy associated with Microsoft Managed Desktop settings.",
+					},
+					"profile": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the Microsoft Managed Desktop profile that the Windows 365 Cloud PC is associated with.",
+					},
+				},
+			},
+			"provisioning_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Specifies the type of license used when provisioning Cloud PCs using this policy.",
+			},
+			"windows_setting": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Indicates a specific Windows setting to configure during the creation of Cloud PCs for this provisioning policy.",
+				Attributes: map[string]schema.Attribute{
+					"locale": schema.StringAttribute{
+						Computed:    true,
+						Description: "The Windows language or region tag to use for language pack configuration and localization of the Cloud PC.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CloudPcProvisioningPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudPcProvisioningPolicyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() && data.ODataFilter.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing lookup attribute",
+			"Exactly one of `id` or `odata_filter` must be set to look up a provisioning policy.",
+		)
+		return
+	}
+
+	if !data.ID.IsNull() && !data.ODataFilter.IsNull() {
+		resp.Diagnostics.AddError(
+			"Conflicting lookup attributes",
+			"Only one of `id` or `odata_filter` may be set to look up a provisioning policy.",
+		)
+		return
+	}
+
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, fmt.Sprintf("Looking up cloud PC provisioning policy by id: %s", data.ID.ValueString()))
+
+		remote, err := d.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(data.ID.ValueString()).Get(ctx, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading cloud PC provisioning policy",
+				fmt.Sprintf("Could not read cloud PC provisioning policy with ID %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		mapProvisioningPolicyToDataSourceModel(&data, remote)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Looking up cloud PC provisioning policy by odata_filter: %s", data.ODataFilter.ValueString()))
+
+	filter := data.ODataFilter.ValueString()
+	requestConfig := &devicemanagement.VirtualEndpointProvisioningPoliciesRequestBuilderGetRequestConfiguration{
+		QueryParameters: &devicemanagement.VirtualEndpointProvisioningPoliciesRequestBuilderGetQueryParameters{
+			Filter: &filter,
+		},
+	}
+
+	remotePolicies, err := d.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().Get(ctx, requestConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing cloud PC provisioning policies",
+			fmt.Sprintf("Could not list cloud PC provisioning policies matching odata_filter %q: %s", filter, err.Error()),
+		)
+		return
+	}
+
+	matches := remotePolicies.GetValue()
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Cloud PC provisioning policy not found",
+			fmt.Sprintf("No cloud PC provisioning policy matched odata_filter %q.", filter),
+		)
+		return
+	}
+	if len(matches) > 1 {
+		ids := make([]string, 0, len(matches))
+		for _, match := range matches {
+			ids = append(ids, *match.GetId())
+		}
+		resp.Diagnostics.AddError(
+			"Multiple cloud PC provisioning policies matched",
+			fmt.Sprintf("Found %d cloud PC provisioning policies matching odata_filter %q: %v. Refine odata_filter to match exactly one.", len(matches), filter, ids),
+		)
+		return
+	}
+
+	mapProvisioningPolicyToDataSourceModel(&data, matches[0])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+)
+
+// mapProvisioningPolicyToDataSourceModel copies every Graph-returned field
+// of a provisioning policy into the data source model shape.
+func mapProvisioningPolicyToDataSourceModel(data *CloudPcProvisioningPolicyDataSourceModel, remote models.CloudPcProvisioningPolicyable) {
+	if remote == nil {
+		return
+	}
+
+	data.ID = types.StringPointerValue(remote.GetId())
+	data.AlternateResourceUrl = types.StringPointerValue(remote.GetAlternateResourceUrl())
+	data.CloudPcGroupDisplayName = types.StringPointerValue(remote.GetCloudPcGroupDisplayName())
+	data.CloudPcNamingTemplate = types.StringPointerValue(remote.GetCloudPcNamingTemplate())
+	data.Description = types.StringPointerValue(remote.GetDescription())
+	data.DisplayName = types.StringPointerValue(remote.GetDisplayName())
+	data.EnableSingleSignOn = types.BoolPointerValue(remote.GetEnableSingleSignOn())
+	data.GracePeriodInHours = types.Int64PointerValue(remote.GetGracePeriodInHours())
+	data.ImageDisplayName = types.StringPointerValue(remote.GetImageDisplayName())
+	data.ImageId = types.StringPointerValue(remote.GetImageId())
+	data.LocalAdminEnabled = types.BoolPointerValue(remote.GetLocalAdminEnabled())
+
+	if imageType := remote.GetImageType(); imageType != nil {
+		data.ImageType = types.StringValue(imageType.String())
+	} else {
+		data.ImageType = types.StringNull()
+	}
+
+	if provisioningType := remote.GetProvisioningType(); provisioningType != nil {
+		data.ProvisioningType = types.StringValue(provisioningType.String())
+	} else {
+		data.ProvisioningType = types.StringNull()
+	}
+
+	configurations := remote.GetDomainJoinConfigurations()
+	data.DomainJoinConfigurations = make([]DomainJoinConfigurationModel, 0, len(configurations))
+	for _, configuration := range configurations {
+		item := DomainJoinConfigurationModel{
+			OnPremisesConnectionId: types.StringPointerValue(configuration.GetOnPremisesConnectionId()),
+			RegionName:             types.StringPointerValue(configuration.GetRegionName()),
+		}
+		if domainJoinType := configuration.GetDomainJoinType(); domainJoinType != nil {
+			item.DomainJoinType = types.StringValue(domainJoinType.String())
+		}
+		data.DomainJoinConfigurations = append(data.DomainJoinConfigurations, item)
+	}
+
+	if managedDesktop := remote.GetMicrosoftManagedDesktop(); managedDesktop != nil {
+		item := &MicrosoftManagedDesktopModel{
+			Profile: types.StringPointerValue(managedDesktop.GetProfile()),
+		}
+		if managedType := managedDesktop.GetManagedType(); managedType != nil {
+			item.ManagedType = types.StringValue(managedType.String())
+		}
+		data.MicrosoftManagedDesktop = item
+	}
+
+	if windowsSetting := remote.GetWindowsSetting(); windowsSetting != nil {
+		data.WindowsSetting = &WindowsSettingModel{
+			Locale: types.StringPointerValue(windowsSetting.GetLocale()),
+		}
+	}
+}
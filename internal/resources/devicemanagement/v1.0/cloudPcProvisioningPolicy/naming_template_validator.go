@@ -0,0 +1,97 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cloudPcNamingTemplateMaxLength is the total character limit Graph applies
+// to a provisioning policy's naming template once every token is expanded
+// to its widest possible value.
+const cloudPcNamingTemplateMaxLength = 15
+
+// cloudPcNamingTemplateValidator enforces the %USERNAME:x% / %RAND:x% token
+// syntax and the 15-character total-length limit Graph applies to
+// cloud_pc_naming_template, so a config that would otherwise fail at apply
+// time (e.g. CPC-%USERNAME:10%-%RAND:10%) is rejected at plan time instead.
+type cloudPcNamingTemplateValidator struct{}
+
+func (v cloudPcNamingTemplateValidator) Description(ctx context.Context) string {
+	return "cloud_pc_naming_template must use only %USERNAME:x% and %RAND:x% tokens (1<=x<=15), and its maximum " +
+		"expanded length must not exceed 15 characters"
+}
+
+func (v cloudPcNamingTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cloudPcNamingTemplateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkCloudPcNamingTemplate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cloud_pc_naming_template",
+			err.Error(),
+		)
+	}
+}
+
+func validateCloudPcNamingTemplate() validator.String {
+	return cloudPcNamingTemplateValidator{}
+}
+
+// checkCloudPcNamingTemplate tokenizes template, resolving each
+// %USERNAME:x% and %RAND:x% token to its x-character budget, and returns an
+// error if any token is malformed or unsupported, any x is outside
+// [1, 15], or the literal characters plus the maximum expanded token
+// lengths exceed cloudPcNamingTemplateMaxLength.
+func checkCloudPcNamingTemplate(template string) error {
+	length := 0
+
+	for i := 0; i < len(template); {
+		if template[i] != '%' {
+			length++
+			i++
+			continue
+		}
+
+		end := strings.IndexByte(template[i+1:], '%')
+		if end == -1 {
+			return fmt.Errorf("unterminated token starting at position %d: %q", i, template[i:])
+		}
+		token := template[i+1 : i+1+end]
+		i += end + 2
+
+		name, widthStr, ok := strings.Cut(token, ":")
+		if !ok {
+			return fmt.Errorf("malformed token %q: expected %%NAME:x%%", "%"+token+"%")
+		}
+
+		if name != "USERNAME" && name != "RAND" {
+			return fmt.Errorf("unknown token %q: only %%USERNAME:x%% and %%RAND:x%% are supported", "%"+token+"%")
+		}
+
+		width, err := strconv.Atoi(widthStr)
+		if err != nil {
+			return fmt.Errorf("token %q has a non-numeric width: %q", "%"+token+"%", widthStr)
+		}
+		if width < 1 || width > cloudPcNamingTemplateMaxLength {
+			return fmt.Errorf("token %q width must be between 1 and %d, got %d", "%"+token+"%", cloudPcNamingTemplateMaxLength, width)
+		}
+
+		length += width
+	}
+
+	if length > cloudPcNamingTemplateMaxLength {
+		return fmt.Errorf("cloud_pc_naming_template expands to at most %d characters, which exceeds the %d-character limit", length, cloudPcNamingTemplateMaxLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckCloudPcNamingTemplateValid(t *testing.T) {
+	tests := map[string]string{
+		"empty":         "",
+		"only literals": "CPC-Workstation",
+		"only tokens":   "%USERNAME:5%%RAND:10%",
+		"mixed":         "CPC-%USERNAME:4%-%RAND:5%",
+		"max length":    "%USERNAME:15%",
+	}
+
+	for name, template := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := checkCloudPcNamingTemplate(template); err != nil {
+				t.Fatalf("checkCloudPcNamingTemplate(%q) returned unexpected error: %v", template, err)
+			}
+		})
+	}
+}
+
+func TestCheckCloudPcNamingTemplateInvalid(t *testing.T) {
+	tests := map[string]struct {
+		template string
+		wantErr  string
+	}{
+		"too long once expanded": {
+			template: "CPC-%USERNAME:10%-%RAND:10%",
+			wantErr:  "exceeds the 15-character limit",
+		},
+		"literal plus tokens too long": {
+			template: "ProvisioningPolicy-%RAND:5%",
+			wantErr:  "exceeds the 15-character limit",
+		},
+		"unknown token": {
+			template: "%HOSTNAME:5%",
+			wantErr:  "unknown token",
+		},
+		"malformed token missing width": {
+			template: "%USERNAME%",
+			wantErr:  "malformed token",
+		},
+		"non-numeric width": {
+			template: "%RAND:abc%",
+			wantErr:  "non-numeric width",
+		},
+		"width out of range low": {
+			template: "%RAND:0%",
+			wantErr:  "width must be between 1 and 15",
+		},
+		"width out of range high": {
+			template: "%RAND:16%",
+			wantErr:  "width must be between 1 and 15",
+		},
+		"unterminated token": {
+			template: "CPC-%USERNAME:5",
+			wantErr:  "unterminated token",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkCloudPcNamingTemplate(tt.template)
+			if err == nil {
+				t.Fatalf("checkCloudPcNamingTemplate(%q) expected error, got nil", tt.template)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkCloudPcNamingTemplate(%q) error = %q, want substring %q", tt.template, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Default deadlines for waitForProvisioningRollout when the user's
+// timeouts block doesn't set create/update explicitly.
+const (
+	defaultProvisioningCreateTimeout = 30 * time.Minute
+	defaultProvisioningUpdateTimeout = 15 * time.Minute
+)
+
+// provisioningStatusPollInterval and provisioningStatusPollDelay mirror the
+// MinTimeout/Delay knobs of an SDKv2 StateChangeConf: Delay gives Graph time
+// to start rolling out before the first poll, and the interval is how often
+// we re-check afterwards.
+const (
+	provisioningStatusPollDelay    = 30 * time.Second
+	provisioningStatusPollInterval = 10 * time.Second
+)
+
+var provisioningPendingStatuses = map[string]bool{
+	"provisioning":        true,
+	"pendingProvisioning": true,
+	"inGracePeriod":       true,
+}
+
+var provisioningFailedStatuses = map[string]bool{
+	"failed":             true,
+	"provisioningFailed": true,
+	"notProvisioned":     true,
+}
+
+// waitForProvisioningRollout polls a provisioning policy's Cloud PC rollout
+// until it reaches a terminal status, the way terraform-provider-google's
+// computeOperationWaitGlobalTime surfaces a long-running GCE operation's
+// progress instead of just confirming the operation object exists. It
+// always returns the last observed status and error message, even when it
+// also returns a non-nil error, so the caller can persist them to state
+// regardless of outcome.
+func (r *CloudPcProvisioningPolicyResource) waitForProvisioningRollout(ctx context.Context, policyID string, timeout time.Duration) (status string, provisioningErr string, err error) {
+	deadline := time.Now().Add(timeout)
+
+	delay := provisioningStatusPollDelay
+	if delay > timeout {
+		delay = timeout
+	}
+	select {
+	case <-ctx.Done():
+		return "", "", ctx.Err()
+	case <-time.After(delay):
+	}
+
+	interval := provisioningStatusPollInterval
+	for {
+		status, provisioningErr, err = r.readProvisioningStatus(ctx, policyID)
+		if err != nil {
+			return status, provisioningErr, err
+		}
+
+		if provisioningFailedStatuses[status] {
+			return status, provisioningErr, fmt.Errorf("cloud PC provisioning reached %q: %s", status, provisioningErr)
+		}
+		if !provisioningPendingStatuses[status] {
+			return status, provisioningErr, nil
+		}
+
+		if time.Now().After(deadline) {
+			return status, provisioningErr, fmt.Errorf("timed out waiting for cloud PC provisioning to leave %q", status)
+		}
+
+		jitter := time.Duration(rand.Int63n(int64(interval) / 2))
+		select {
+		case <-ctx.Done():
+			return status, provisioningErr, ctx.Err()
+		case <-time.After(interval + jitter):
+		}
+	}
+}
+
+// readProvisioningStatus derives a single provisioning status for the
+// policy from whether its alternateResourceUrl is set and whether its
+// assignedUsers list is non-empty, since Graph surfaces Cloud PC rollout
+// progress per-device rather than as a single field on the policy itself.
+// It only ever reports pendingProvisioning, provisioning or provisioned,
+// with an empty error message; per-user provisioning failures are not
+// inspected.
+func (r *CloudPcProvisioningPolicyResource) readProvisioningStatus(ctx context.Context, policyID string) (status string, provisioningErr string, err error) {
+	policy, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(policyID).Get(ctx, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if policy.GetAlternateResourceUrl() == nil || *policy.GetAlternateResourceUrl() == "" {
+		return "pendingProvisioning", "", nil
+	}
+
+	assignedUsers, err := r.client.DeviceManagement().VirtualEndpoint().ProvisioningPolicies().ByCloudPcProvisioningPolicyId(policyID).AssignedUsers().Get(ctx, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(assignedUsers.GetValue()) == 0 {
+		return "provisioning", "", nil
+	}
+
+	return "provisioned", "", nil
+}
@@ -0,0 +1,32 @@
+package graphCloudPcProvisioningPolicy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Registration implements provider.ServiceRegistration for the Cloud PC
+// provisioning policy subsystem.
+type Registration struct{}
+
+// Name returns the stable identifier used for registration ordering and
+// duplicate detection.
+func (Registration) Name() string {
+	return "device_management/cloud_pc_provisioning_policy"
+}
+
+// Resources returns the Cloud PC provisioning policy resource and its
+// assignment resource.
+func (Registration) Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewCloudPcProvisioningPolicyResource,
+		NewCloudPcProvisioningPolicyAssignmentResource,
+	}
+}
+
+// DataSources returns the provisioning policy lookup data source.
+func (Registration) DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewCloudPcProvisioningPolicyDataSource,
+	}
+}
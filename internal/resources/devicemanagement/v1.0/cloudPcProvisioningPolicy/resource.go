@@ -27,6 +27,7 @@ func NewCloudPcProvisioningPolicyResource() resource.Resource {
 
 type CloudPcProvisioningPolicyResource struct {
 	client           *msgraphsdk.GraphServiceClient
+	batch            *client.BatchExecutor
 	ProviderTypeName string
 	TypeName         string
 }
@@ -48,6 +49,9 @@ type CloudPcProvisioningPolicyResourceModel struct {
 	MicrosoftManagedDesktop  *MicrosoftManagedDesktopModel  `tfsdk:"microsoft_managed_desktop"`
 	ProvisioningType         types.String                   `tfsdk:"provisioning_type"`
 	WindowsSetting           *WindowsSettingModel           `tfsdk:"windows_setting"`
+	SkipWaitForProvisioning  types.Bool                     `tfsdk:"skip_wait_for_provisioning"`
+	LastProvisioningStatus   types.String                   `tfsdk:"last_provisioning_status"`
+	LastProvisioningError    types.String                   `tfsdk:"last_provisioning_error"`
 	Timeouts                 timeouts.Value                 `tfsdk:"timeouts"`
 }
 
@@ -109,6 +113,7 @@ func (r *CloudPcProvisioningPolicyResource) Configure(ctx context.Context, req r
 	}
 
 	r.client = clients.StableClient
+	r.batch = clients.StableBatch
 	tflog.Debug(ctx, "Initialized graphCloudPcProvisioningPolicy resource with Graph Client")
 }
 
@@ -137,6 +142,9 @@ func (r *CloudPcProvisioningPolicyResource) Schema(ctx context.Context, req reso
 				Required: true,
 				Description: "The template used to name Cloud PCs provisioned using this policy. The template can contain custom text and replacement tokens, including %USERNAME:x% and %RAND:x%, which represent the user's name and a randomly generated number, respectively. " +
 					"For example, CPC-%USERNAME:4%-%RAND:5% means that the name of the Cloud PC starts with CPC-, followed by a four-character username, a - character, and then five random characters. The total length of the text generated by the template can't exceed 15 characters. Supports $filter, $select, and $orderby.",
+				Validators: []validator.String{
+					validateCloudPcNamingTemplate(),
+				},
 			},
 			"description": schema.StringAttribute{
 				Optional:    true,
@@ -237,6 +245,18 @@ func (r *CloudPcProvisioningPolicyResource) Schema(ctx context.Context, req reso
 					},
 				},
 			},
+			"skip_wait_for_provisioning": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, Create and Update return as soon as the policy object itself is written, without waiting for its Cloud PCs to finish provisioning. Defaults to false.",
+			},
+			"last_provisioning_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The last observed Cloud PC provisioning status for this policy, one of `pendingProvisioning`, `provisioning`, or `provisioned`. Failed rollouts are not detected, so no failure status is ever reported. Null when skip_wait_for_provisioning is true.",
+			},
+			"last_provisioning_error": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error message associated with last_provisioning_status, if any. The status checks report no error messages, so after a wait this is an empty string. Null when skip_wait_for_provisioning is true.",
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Read:   true,
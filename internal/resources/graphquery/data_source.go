@@ -0,0 +1,282 @@
+// Package graphquery provides an escape-hatch data source for issuing
+// ad-hoc Microsoft Graph requests through the provider's already-configured
+// HTTP client and credential, for endpoints this provider does not yet
+// expose a typed resource or data source for.
+package graphquery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxPages bounds automatic @odata.nextLink following so a misbehaving or
+// unbounded collection cannot hang a plan indefinitely.
+const maxPages = 50
+
+var (
+	_ datasource.DataSource              = &QueryDataSource{}
+	_ datasource.DataSourceWithConfigure = &QueryDataSource{}
+)
+
+// NewGraphQueryDataSource returns the `microsoft365_graph_query` data source,
+// which targets the Graph v1.0 service root.
+func NewGraphQueryDataSource() datasource.DataSource {
+	return &QueryDataSource{apiVersion: "v1.0"}
+}
+
+// NewGraphBetaQueryDataSource returns the `microsoft365_graph_beta_query`
+// data source, which targets the Graph beta service root.
+func NewGraphBetaQueryDataSource() datasource.DataSource {
+	return &QueryDataSource{apiVersion: "beta"}
+}
+
+// QueryDataSource issues a single ad-hoc HTTP request against Microsoft
+// Graph, as a fallback for endpoints this provider doesn't yet have a typed
+// resource or data source for.
+type QueryDataSource struct {
+	httpClient  *http.Client
+	credential  azcore.TokenCredential
+	apiScope    string
+	serviceRoot string
+	apiVersion  string
+}
+
+type QueryDataSourceModel struct {
+	APIVersion      types.String `tfsdk:"api_version"`
+	Method          types.String `tfsdk:"method"`
+	URL             types.String `tfsdk:"url"`
+	Headers         types.Map    `tfsdk:"headers"`
+	RequestBody     types.String `tfsdk:"request_body"`
+	ResponseBody    types.String `tfsdk:"response_body"`
+	ResponseHeaders types.Map    `tfsdk:"response_headers"`
+	Results         types.List   `tfsdk:"results"`
+}
+
+func (d *QueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	if d.apiVersion == "beta" {
+		resp.TypeName = req.ProviderTypeName + "_graph_beta_query"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_graph_query"
+}
+
+func (d *QueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.httpClient = clients.HTTPClient
+	d.credential = clients.Credential
+	d.apiScope = clients.APIScope
+	if d.apiVersion == "beta" {
+		d.serviceRoot = clients.GraphBetaServiceRoot
+	} else {
+		d.serviceRoot = clients.GraphServiceRoot
+	}
+}
+
+func (d *QueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Issues an ad-hoc Microsoft Graph %s request. Intended as an escape hatch for reading "+
+			"endpoints this provider does not yet expose a typed resource or data source for.", d.apiVersion),
+		Attributes: map[string]schema.Attribute{
+			"api_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Graph API version this data source targets, either `v1.0` or `beta`.",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "The HTTP method to use. One of `GET` or `POST`. Defaults to `GET`.",
+			},
+			"url": schema.StringAttribute{
+				Required: true,
+				Description: "The relative Graph URL to request, e.g. `/users?$select=displayName&$filter=...` " +
+					"or `/me/messages/$search(...)`.",
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
+				Description: "Additional HTTP headers to send with the request.",
+				ElementType: types.StringType,
+			},
+			"request_body": schema.StringAttribute{
+				Optional:    true,
+				Description: "A raw JSON request body, used with `method = \"POST\"` (e.g. for `$search` or `$batch`).",
+			},
+			"response_body": schema.StringAttribute{
+				Computed:    true,
+				Description: "The raw JSON response body of the last page fetched.",
+			},
+			"response_headers": schema.MapAttribute{
+				Computed:    true,
+				Description: "The HTTP response headers of the last page fetched.",
+				ElementType: types.StringType,
+			},
+			"results": schema.ListAttribute{
+				Computed: true,
+				Description: "If the response body is an OData collection (a JSON object with a `value` array), " +
+					"each element JSON-encoded as a string, with `@odata.nextLink` pages automatically followed and " +
+					"concatenated (up to 50 pages).",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *QueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QueryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method := strings.ToUpper(data.Method.ValueString())
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	headers := map[string]string{}
+	if !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	nextURL := d.requestURL(data.URL.ValueString())
+	requestBody := data.RequestBody.ValueString()
+	var lastBody []byte
+	var lastHeaders http.Header
+	var results []string
+
+	for page := 0; page < maxPages && nextURL != ""; page++ {
+		body, respHeaders, decoded, err := d.do(ctx, method, nextURL, headers, requestBody)
+		if err != nil {
+			resp.Diagnostics.AddError("Error issuing Microsoft Graph request", err.Error())
+			return
+		}
+
+		lastBody = body
+		lastHeaders = respHeaders
+
+		if values, ok := decoded["value"].([]interface{}); ok {
+			for _, v := range values {
+				encoded, err := json.Marshal(v)
+				if err != nil {
+					resp.Diagnostics.AddError("Error encoding Microsoft Graph result", err.Error())
+					return
+				}
+				results = append(results, string(encoded))
+			}
+		}
+
+		nextURL, _ = decoded["@odata.nextLink"].(string)
+		// Only the initial request carries a body; subsequent paged
+		// requests are always plain GETs against the returned nextLink.
+		method = http.MethodGet
+		requestBody = ""
+	}
+
+	data.ResponseBody = types.StringValue(string(lastBody))
+
+	respHeadersValue, diags := types.MapValueFrom(ctx, types.StringType, flattenHeaders(lastHeaders))
+	resp.Diagnostics.Append(diags...)
+	data.ResponseHeaders = respHeadersValue
+
+	resultsValue, diags := types.ListValueFrom(ctx, types.StringType, results)
+	resp.Diagnostics.Append(diags...)
+	data.Results = resultsValue
+
+	data.APIVersion = types.StringValue(d.apiVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// requestURL resolves a user-supplied relative (or absolute) Graph URL
+// against this data source's configured service root.
+func (d *QueryDataSource) requestURL(raw string) string {
+	if strings.HasPrefix(raw, "http://") || strings.HasPrefix(raw, "https://") {
+		return raw
+	}
+	return strings.TrimRight(d.serviceRoot, "/") + "/" + strings.TrimLeft(raw, "/")
+}
+
+func (d *QueryDataSource) do(ctx context.Context, method, url string, headers map[string]string, requestBody string) ([]byte, http.Header, map[string]interface{}, error) {
+	var bodyReader io.Reader
+	if requestBody != "" {
+		bodyReader = strings.NewReader(requestBody)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	token, err := d.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{d.apiScope}})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to obtain access token: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+token.Token)
+	httpReq.Header.Set("Accept", "application/json")
+	if requestBody != "" {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Issuing ad-hoc Microsoft Graph request: %s %s", method, url))
+
+	httpResp, err := d.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if httpResp.StatusCode >= 400 {
+		return nil, nil, nil, fmt.Errorf("Graph returned status %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	decoded := map[string]interface{}{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &decoded); err != nil {
+			return body, httpResp.Header, decoded, fmt.Errorf("failed to decode JSON response: %w", err)
+		}
+	}
+
+	return body, httpResp.Header, decoded, nil
+}
+
+func flattenHeaders(h http.Header) map[string]string {
+	flat := make(map[string]string, len(h))
+	for k := range h {
+		flat[k] = h.Get(k)
+	}
+	return flat
+}
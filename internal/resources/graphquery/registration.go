@@ -0,0 +1,29 @@
+package graphquery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Registration implements provider.ServiceRegistration for the ad-hoc Graph
+// query subsystem.
+type Registration struct{}
+
+// Name returns the stable identifier used for registration ordering and
+// duplicate detection.
+func (Registration) Name() string {
+	return "graphquery"
+}
+
+// Resources returns no resources; this subsystem only exposes data sources.
+func (Registration) Resources() []func() resource.Resource {
+	return nil
+}
+
+// DataSources returns the stable and beta ad-hoc Graph query data sources.
+func (Registration) DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewGraphQueryDataSource,
+		NewGraphBetaQueryDataSource,
+	}
+}
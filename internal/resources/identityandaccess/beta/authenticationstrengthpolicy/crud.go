@@ -0,0 +1,246 @@
+package graphBetaAuthenticationStrengthPolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoftgraph/msgraph-beta-sdk-go/models"
+)
+
+// constructResource builds the Graph request body for a Create or Update
+// call from the Terraform plan.
+func constructResource(data *AuthenticationStrengthPolicyResourceModel) (*models.AuthenticationStrengthPolicy, error) {
+	requestBody := models.NewAuthenticationStrengthPolicy()
+
+	requestBody.SetDisplayName(data.DisplayName.ValueStringPointer())
+	requestBody.SetDescription(data.Description.ValueStringPointer())
+
+	allowedCombinations := make([]string, 0, len(data.AllowedCombinations))
+	for _, combination := range data.AllowedCombinations {
+		allowedCombinations = append(allowedCombinations, combination.ValueString())
+	}
+	requestBody.SetAllowedCombinations(allowedCombinations)
+
+	if len(data.CombinationConfigurations) > 0 {
+		configurations := make([]models.AuthenticationCombinationConfigurationable, 0, len(data.CombinationConfigurations))
+		for _, configuration := range data.CombinationConfigurations {
+			built, err := constructCombinationConfiguration(&configuration)
+			if err != nil {
+				return nil, fmt.Errorf("error constructing combination configuration: %w", err)
+			}
+			configurations = append(configurations, built)
+		}
+		requestBody.SetCombinationConfigurations(configurations)
+	}
+
+	return requestBody, nil
+}
+
+func constructCombinationConfiguration(data *CombinationConfigurationModel) (models.AuthenticationCombinationConfigurationable, error) {
+	appliesTo := make([]string, 0, len(data.AppliesToCombinations))
+	for _, combination := range data.AppliesToCombinations {
+		appliesTo = append(appliesTo, combination.ValueString())
+	}
+
+	if data.Fido2 != nil && data.X509Certificate != nil {
+		return nil, fmt.Errorf("combination configuration must set exactly one of fido2 or x509_certificate")
+	}
+
+	switch {
+	case data.Fido2 != nil:
+		configuration := models.NewFido2CombinationConfiguration()
+		configuration.SetAppliesToCombinations(appliesTo)
+
+		allowedAaguids := make([]string, 0, len(data.Fido2.AllowedAaguids))
+		for _, aaguid := range data.Fido2.AllowedAaguids {
+			allowedAaguids = append(allowedAaguids, aaguid.ValueString())
+		}
+		configuration.SetAllowedAAGUIDs(allowedAaguids)
+
+		return configuration, nil
+	case data.X509Certificate != nil:
+		configuration := models.NewX509CertificateCombinationConfiguration()
+		configuration.SetAppliesToCombinations(appliesTo)
+
+		allowedIssuerSkis := make([]string, 0, len(data.X509Certificate.AllowedIssuerSkis))
+		for _, ski := range data.X509Certificate.AllowedIssuerSkis {
+			allowedIssuerSkis = append(allowedIssuerSkis, ski.ValueString())
+		}
+		configuration.SetAllowedIssuerSkis(allowedIssuerSkis)
+
+		if !data.X509Certificate.AuthenticationMode.IsNull() {
+			mode, err := models.ParseX509CertificateAuthenticationMode(data.X509Certificate.AuthenticationMode.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing x509 certificate authentication mode: %w", err)
+			}
+			if mode != nil {
+				value := mode.(models.X509CertificateAuthenticationMode)
+				configuration.SetX509CertificateAuthenticationMode(&value)
+			}
+		}
+
+		return configuration, nil
+	default:
+		return nil, fmt.Errorf("combination configuration must set exactly one of fido2 or x509_certificate")
+	}
+}
+
+// mapRemoteStateToTerraform copies the Graph-returned fields back onto the
+// model; allowed_combinations and combination_configurations already
+// reflect the plan the caller just applied.
+func mapRemoteStateToTerraform(data *AuthenticationStrengthPolicyResourceModel, remote models.AuthenticationStrengthPolicyable) {
+	data.ID = types.StringPointerValue(remote.GetId())
+	data.PolicyType = types.StringPointerValue(remote.GetPolicyType())
+	data.RequirementsSatisfied = types.StringPointerValue(remote.GetRequirementsSatisfied())
+}
+
+// Create handles the Create operation.
+func (r *AuthenticationStrengthPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AuthenticationStrengthPolicyResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	requestBody, err := constructResource(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error constructing authentication strength policy",
+			fmt.Sprintf("Could not construct resource: %s_%s: %s", r.ProviderTypeName, r.TypeName, err.Error()),
+		)
+		return
+	}
+
+	policy, err := r.client.Identity().ConditionalAccess().AuthenticationStrength().Policies().Post(ctx, requestBody, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating authentication strength policy",
+			fmt.Sprintf("Could not create authentication strength policy: %s", err.Error()),
+		)
+		return
+	}
+
+	mapRemoteStateToTerraform(&plan, policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creation of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+}
+
+// Read handles the Read operation.
+func (r *AuthenticationStrengthPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AuthenticationStrengthPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	policy, err := r.client.Identity().ConditionalAccess().AuthenticationStrength().Policies().ByAuthenticationStrengthPolicyId(state.ID.ValueString()).Get(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading authentication strength policy",
+			fmt.Sprintf("Could not read authentication strength policy with ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mapRemoteStateToTerraform(&state, policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update handles the Update operation.
+func (r *AuthenticationStrengthPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AuthenticationStrengthPolicyResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting Update of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	requestBody, err := constructResource(&data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error constructing authentication strength policy",
+			fmt.Sprintf("Could not construct resource: %s_%s: %s", r.ProviderTypeName, r.TypeName, err.Error()),
+		)
+		return
+	}
+
+	_, err = r.client.Identity().ConditionalAccess().AuthenticationStrength().Policies().ByAuthenticationStrengthPolicyId(data.ID.ValueString()).Patch(ctx, requestBody, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating authentication strength policy",
+			fmt.Sprintf("Could not update resource: %s_%s: %s", r.ProviderTypeName, r.TypeName, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished Update of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+}
+
+// Delete handles the Delete operation.
+func (r *AuthenticationStrengthPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AuthenticationStrengthPolicyResourceModel
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting deletion of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Identity().ConditionalAccess().AuthenticationStrength().Policies().ByAuthenticationStrengthPolicyId(data.ID.ValueString()).Delete(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s_%s", r.ProviderTypeName, r.TypeName), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Completed deletion of resource: %s_%s", r.ProviderTypeName, r.TypeName))
+
+	resp.State.RemoveResource(ctx)
+}
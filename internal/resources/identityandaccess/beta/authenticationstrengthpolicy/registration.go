@@ -0,0 +1,29 @@
+package graphBetaAuthenticationStrengthPolicy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Registration implements provider.ServiceRegistration for the
+// authentication strength policy subsystem.
+type Registration struct{}
+
+// Name returns the stable identifier used for registration ordering and
+// duplicate detection.
+func (Registration) Name() string {
+	return "identity_and_access/authentication_strength_policy"
+}
+
+// Resources returns the authentication strength policy resource.
+func (Registration) Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewAuthenticationStrengthPolicyResource,
+	}
+}
+
+// DataSources returns no data sources; this subsystem does not expose any
+// yet.
+func (Registration) DataSources() []func() datasource.DataSource {
+	return nil
+}
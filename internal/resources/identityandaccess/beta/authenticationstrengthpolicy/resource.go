@@ -0,0 +1,207 @@
+// REF: https://learn.microsoft.com/en-us/graph/api/resources/authenticationstrengthpolicy?view=graph-rest-beta
+package graphBetaAuthenticationStrengthPolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+)
+
+var _ resource.Resource = &AuthenticationStrengthPolicyResource{}
+var _ resource.ResourceWithConfigure = &AuthenticationStrengthPolicyResource{}
+var _ resource.ResourceWithImportState = &AuthenticationStrengthPolicyResource{}
+
+func NewAuthenticationStrengthPolicyResource() resource.Resource {
+	return &AuthenticationStrengthPolicyResource{}
+}
+
+// AuthenticationStrengthPolicyResource manages a custom authentication
+// strength policy that conditional access policies can reference by ID from
+// their grant_controls.authentication_strength attribute, instead of each
+// policy duplicating its own inline combination of allowed authentication
+// methods.
+type AuthenticationStrengthPolicyResource struct {
+	client           *msgraphbetasdk.GraphServiceClient
+	ProviderTypeName string
+	TypeName         string
+}
+
+type AuthenticationStrengthPolicyResourceModel struct {
+	ID                        types.String                    `tfsdk:"id"`
+	DisplayName               types.String                    `tfsdk:"display_name"`
+	Description               types.String                    `tfsdk:"description"`
+	PolicyType                types.String                    `tfsdk:"policy_type"`
+	RequirementsSatisfied     types.String                    `tfsdk:"requirements_satisfied"`
+	AllowedCombinations       []types.String                  `tfsdk:"allowed_combinations"`
+	CombinationConfigurations []CombinationConfigurationModel `tfsdk:"combination_configurations"`
+	Timeouts                  timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+type CombinationConfigurationModel struct {
+	ID                    types.String                                  `tfsdk:"id"`
+	AppliesToCombinations []types.String                                `tfsdk:"applies_to_combinations"`
+	Fido2                 *Fido2CombinationConfigurationModel           `tfsdk:"fido2"`
+	X509Certificate       *X509CertificateCombinationConfigurationModel `tfsdk:"x509_certificate"`
+}
+
+type Fido2CombinationConfigurationModel struct {
+	AllowedAaguids []types.String `tfsdk:"allowed_aaguids"`
+}
+
+type X509CertificateCombinationConfigurationModel struct {
+	AllowedIssuerSkis  []types.String `tfsdk:"allowed_issuer_skis"`
+	AuthenticationMode types.String   `tfsdk:"authentication_mode"`
+}
+
+// GetID returns the ID of a resource from the state model.
+func (s *AuthenticationStrengthPolicyResourceModel) GetID() string {
+	return s.ID.ValueString()
+}
+
+// GetTypeName returns the type name of the resource from the state model.
+func (r *AuthenticationStrengthPolicyResource) GetTypeName() string {
+	return r.TypeName
+}
+
+// Metadata returns the resource type name.
+func (r *AuthenticationStrengthPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_beta_authentication_strength_policy"
+}
+
+// Configure sets the client for the resource.
+func (r *AuthenticationStrengthPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Configuring AuthenticationStrengthPolicyResource")
+
+	if req.ProviderData == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. This leads to weird stuff happening, so we reject this. Please report this to the provider developers.",
+		)
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if clients.BetaClient == nil {
+		resp.Diagnostics.AddError(
+			"BetaClient is nil",
+			"The BetaClient in the provider data is nil. This could indicate a configuration error in the provider. Please check your provider configuration and try again.",
+		)
+		return
+	}
+
+	r.client = clients.BetaClient
+	tflog.Debug(ctx, "Initialized graphBetaAuthenticationStrengthPolicy resource with BetaClient")
+}
+
+// ImportState imports the resource state.
+func (r *AuthenticationStrengthPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Schema returns the schema for the resource.
+func (r *AuthenticationStrengthPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom authentication strength policy under `/identity/conditionalAccess/authenticationStrength/policies`. " +
+			"Reference built-in strengths (mfa, passwordlessMFA, phishingResistantMFA) directly by ID in a conditional access policy's " +
+			"grant_controls.authentication_strength without needing this resource; use this resource only to define a custom combination.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the authentication strength policy.",
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The display name for the authentication strength policy.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description for the authentication strength policy.",
+			},
+			"policy_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Indicates the type of authentication strength policy. Possible values are: builtIn, custom. Read-only.",
+			},
+			"requirements_satisfied": schema.StringAttribute{
+				Computed:    true,
+				Description: "Indicates the requirements satisfied by the authentication strength policy, e.g. 'mfa'. Read-only.",
+			},
+			"allowed_combinations": schema.ListAttribute{
+				Required:    true,
+				Description: "The authentication method combinations that satisfy this authentication strength, e.g. 'password,sms' or 'fido2'.",
+				ElementType: types.StringType,
+			},
+			"combination_configurations": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Configurations that restrict an allowed combination to specific credentials, e.g. a specific FIDO2 key model or X.509 certificate issuer.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the combination configuration.",
+						},
+						"applies_to_combinations": schema.ListAttribute{
+							Required:    true,
+							Description: "The allowed combination(s) this configuration restricts, e.g. 'fido2'.",
+							ElementType: types.StringType,
+						},
+						"fido2": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Restricts the 'fido2' combination to specific FIDO2 security key models.",
+							Attributes: map[string]schema.Attribute{
+								"allowed_aaguids": schema.ListAttribute{
+									Required:    true,
+									Description: "The AAGUIDs of the FIDO2 security key models allowed to satisfy this combination.",
+									ElementType: types.StringType,
+								},
+							},
+						},
+						"x509_certificate": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Restricts the 'x509CertificateSingleFactor'/'x509CertificateMultiFactor' combinations to specific certificate issuers.",
+							Attributes: map[string]schema.Attribute{
+								"allowed_issuer_skis": schema.ListAttribute{
+									Required:    true,
+									Description: "The subject key identifiers of the certificate authorities allowed to satisfy this combination.",
+									ElementType: types.StringType,
+								},
+								"authentication_mode": schema.StringAttribute{
+									Required:    true,
+									Description: "Whether this certificate configuration satisfies single-factor or multi-factor authentication. Possible values are: x509CertificateSingleFactor, x509CertificateMultiFactor.",
+									Validators: []validator.String{
+										stringvalidator.OneOf("x509CertificateSingleFactor", "x509CertificateMultiFactor"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
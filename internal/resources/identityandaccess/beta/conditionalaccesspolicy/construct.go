@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/microsoftgraph/msgraph-beta-sdk-go/models"
@@ -24,6 +26,23 @@ func constructResource(ctx context.Context, data *ConditionalAccessPolicyResourc
 
 	if !data.State.IsNull() {
 		stateStr := data.State.ValueString()
+
+		// report_only_then_enable is a pseudo-state this provider manages,
+		// not a value Graph accepts directly: the policy is always written
+		// with state = enabledForReportingButNotEnforced. The promotion
+		// decision is EvaluateReportOnlyPromotion in reportonlypromotion.go;
+		// nothing in this package calls it or writes state = enabled, so a
+		// later apply does not promote the policy.
+		if stateStr == reportOnlyThenEnableState {
+			if data.ReportOnlyBakePeriod.IsNull() || data.ReportOnlyBakePeriod.ValueString() == "" {
+				return nil, fmt.Errorf("report_only_bake_period must be set when state = %q", reportOnlyThenEnableState)
+			}
+			if _, err := time.ParseDuration(data.ReportOnlyBakePeriod.ValueString()); err != nil {
+				return nil, fmt.Errorf("invalid report_only_bake_period: %s", err)
+			}
+			stateStr = "enabledForReportingButNotEnforced"
+		}
+
 		stateAny, err := models.ParseConditionalAccessPolicyState(stateStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid state: %s", err)
@@ -45,6 +64,10 @@ func constructResource(ctx context.Context, data *ConditionalAccessPolicyResourc
 		requestBody.SetConditions(conditions)
 	}
 
+	if err := validateGrantOrSessionControls(data); err != nil {
+		return nil, err
+	}
+
 	if data.GrantControls != nil {
 		grantControls, err := constructGrantControls(data.GrantControls)
 		if err != nil {
@@ -78,6 +101,44 @@ func constructResource(ctx context.Context, data *ConditionalAccessPolicyResourc
 	return requestBody, nil
 }
 
+// validateGrantOrSessionControls enforces the "at least one of grant_controls
+// or session_controls" rule Graph applies to conditional access policies, and
+// rejects session_controls-only policies that would be ineffectual because
+// the policy grants no control and also doesn't scope in any users/groups/
+// roles and applications for a session control to apply to.
+//
+// This check belongs on a resource-level ConfigValidator so it runs at plan
+// time rather than here at apply time, but that requires the Resource/Schema
+// scaffolding for this package, which doesn't exist yet (see the other files
+// under conditionalaccesspolicy). It's enforced here in the meantime so a
+// misconfigured policy still fails before round-tripping a 400 from Graph;
+// move this logic into a ConfigValidators() implementation once the
+// scaffolding lands.
+func validateGrantOrSessionControls(data *ConditionalAccessPolicyResourceModel) error {
+	if data.GrantControls == nil && data.SessionControls == nil {
+		return fmt.Errorf("at least one of grant_controls or session_controls must be set")
+	}
+
+	if data.GrantControls != nil || data.SessionControls == nil {
+		return nil
+	}
+
+	hasUsers := data.Conditions != nil && data.Conditions.Users != nil &&
+		(len(data.Conditions.Users.IncludeUsers) > 0 ||
+			len(data.Conditions.Users.IncludeGroups) > 0 ||
+			len(data.Conditions.Users.IncludeRoles) > 0 ||
+			data.Conditions.Users.IncludeGuestsOrExternalUsers != nil)
+
+	hasApplications := data.Conditions != nil && data.Conditions.Applications != nil &&
+		len(data.Conditions.Applications.IncludeApplications) > 0
+
+	if !hasUsers || !hasApplications {
+		return fmt.Errorf("session_controls is set without grant_controls, but conditions does not include any users/groups/roles together with applications for a session to apply to; this policy would be ineffectual")
+	}
+
+	return nil
+}
+
 // Helper functions to construct nested objects
 func constructConditions(data *ConditionalAccessConditionsModel) (*models.ConditionalAccessConditionSet, error) {
 	if data == nil {
@@ -402,8 +463,65 @@ func constructClientApplications(data *ConditionalAccessClientApplicationsModel)
 	return clientApps, nil
 }
 
+// constructDevices maps the devices.filter block to a
+// ConditionalAccessDevices whose Filter is a ConditionalAccessFilter
+// (mode + rule). The rule string is validated against the device filter
+// grammar Graph accepts (see devicefilter.go) so a malformed or
+// unsupported rule fails at construct time with a diagnostic that points
+// at the offending token, instead of round-tripping a 400 from Graph.
+func constructDevices(data *ConditionalAccessDevicesModel) (models.ConditionalAccessDevicesable, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	devices := models.NewConditionalAccessDevices()
+
+	if len(data.IncludeDevices) > 0 {
+		includeDevices := make([]string, len(data.IncludeDevices))
+		for i, device := range data.IncludeDevices {
+			includeDevices[i] = device.ValueString()
+		}
+		devices.SetIncludeDevices(includeDevices)
+	}
+
+	if len(data.ExcludeDevices) > 0 {
+		excludeDevices := make([]string, len(data.ExcludeDevices))
+		for i, device := range data.ExcludeDevices {
+			excludeDevices[i] = device.ValueString()
+		}
+		devices.SetExcludeDevices(excludeDevices)
+	}
+
+	if data.Filter != nil {
+		rule := data.Filter.Rule.ValueString()
+		if err := validateDeviceFilterRule(rule); err != nil {
+			return nil, fmt.Errorf("invalid devices.filter.rule: %v", err)
+		}
+
+		filter := models.NewConditionalAccessFilter()
+		filter.SetRule(&rule)
+
+		if !data.Filter.Mode.IsNull() {
+			modeAny, err := models.ParseFilterMode(data.Filter.Mode.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing devices.filter.mode: %v", err)
+			}
+			if modeAny != nil {
+				mode, ok := modeAny.(*models.FilterMode)
+				if !ok {
+					return nil, fmt.Errorf("unexpected type for devices.filter.mode: %T", modeAny)
+				}
+				filter.SetMode(mode)
+			}
+		}
+
+		devices.SetFilter(filter)
+	}
+
+	return devices, nil
+}
+
 // Implement similar functions for other nested objects:
-// func constructDevices(data *ConditionalAccessDevicesModel) (models.ConditionalAccessDevicesable, error)
 // func constructDeviceStates(data *ConditionalAccessDeviceStatesModel) (models.ConditionalAccessDeviceStatesable, error)
 // func constructLocations(data *ConditionalAccessLocationsModel) (models.ConditionalAccessLocationsable, error)
 // func constructPlatforms(data *ConditionalAccessPlatformsModel) (models.ConditionalAccessPlatformsable, error)
@@ -463,40 +581,25 @@ func constructGrantControls(data *ConditionalAccessGrantControlsModel) (*models.
 	return grantControls, nil
 }
 
+// constructAuthenticationStrength builds a reference-only
+// AuthenticationStrengthPolicy from grant_controls.authentication_strength's
+// `id`. The policy itself - built-in (mfa, passwordlessMFA,
+// phishingResistantMFA) or a custom one managed with the
+// graph_beta_authentication_strength_policy resource - is never constructed
+// here; only its ID is sent so Graph can attach the existing policy to this
+// conditional access policy's grant controls.
 func constructAuthenticationStrength(data *AuthenticationStrengthPolicyModel) (*models.AuthenticationStrengthPolicy, error) {
 	if data == nil {
 		return nil, nil
 	}
 
-	authStrength := models.NewAuthenticationStrengthPolicy()
-
-	if !data.DisplayName.IsNull() {
-		displayName := data.DisplayName.ValueString()
-		authStrength.SetDisplayName(&displayName)
-	}
-
-	if !data.Description.IsNull() {
-		description := data.Description.ValueString()
-		authStrength.SetDescription(&description)
-	}
-
-	if !data.PolicyType.IsNull() {
-		policyType := data.PolicyType.ValueString()
-		authStrength.SetPolicyType(&policyType)
+	if data.ID.IsNull() || data.ID.ValueString() == "" {
+		return nil, fmt.Errorf("grant_controls.authentication_strength.id must be set to the ID of a built-in or custom authentication strength policy")
 	}
 
-	if !data.RequirementsSatisfied.IsNull() {
-		requirementsSatisfied := data.RequirementsSatisfied.ValueString()
-		authStrength.SetRequirementsSatisfied(&requirementsSatisfied)
-	}
-
-	if len(data.AllowedCombinations) > 0 {
-		allowedCombinations := make([]string, len(data.AllowedCombinations))
-		for i, combination := range data.AllowedCombinations {
-			allowedCombinations[i] = combination.ValueString()
-		}
-		authStrength.SetAllowedCombinations(allowedCombinations)
-	}
+	authStrength := models.NewAuthenticationStrengthPolicy()
+	id := data.ID.ValueString()
+	authStrength.SetId(&id)
 
 	return authStrength, nil
 }
@@ -555,41 +658,60 @@ if data.PersistentBrowser != nil {
 }
 
 if data.SignInFrequency != nil {
+	if !data.SignInFrequency.Duration.IsNull() && data.SignInFrequency.Duration.ValueString() != "" {
+		value, freqType, err := parseSignInFrequencyDuration(data.SignInFrequency.Duration.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing sign_in_frequency duration shorthand: %v", err)
+		}
+		data.SignInFrequency.Value = types.Int32Value(value)
+		data.SignInFrequency.Type = types.StringValue(freqType)
+	}
+
+	if err := validateSignInFrequency(data.SignInFrequency); err != nil {
+		return nil, err
+	}
+
+	everyTime := !data.SignInFrequency.FrequencyInterval.IsNull() &&
+		data.SignInFrequency.FrequencyInterval.ValueString() == "everyTime"
+
 	signInFrequency := models.NewSignInFrequencySessionControl()
-	
+
 	// SetIsEnabled is inherited from ConditionalAccessSessionControl
 	isEnabled := data.SignInFrequency.IsEnabled.ValueBool()
 	signInFrequency.SetIsEnabled(&isEnabled)
-	
-	if !data.SignInFrequency.Type.IsNull() {
-			freqType, err := models.ParseSigninFrequencyType(data.SignInFrequency.Type.ValueString())
-			if err != nil {
-					return nil, fmt.Errorf("error parsing sign-in frequency type: %v", err)
-			}
-			signInFrequency.SetTypeEscaped(freqType)
+
+	// Graph rejects value/type alongside frequency_interval = "everyTime",
+	// so both are left unset in that case regardless of what was resolved
+	// above.
+	if !everyTime && !data.SignInFrequency.Type.IsNull() {
+		freqType, err := models.ParseSigninFrequencyType(data.SignInFrequency.Type.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing sign-in frequency type: %v", err)
+		}
+		signInFrequency.SetTypeEscaped(freqType)
 	}
-	
-	if !data.SignInFrequency.Value.IsNull() {
-			value := data.SignInFrequency.Value.ValueInt32()
-			signInFrequency.SetValue(&value)
+
+	if !everyTime && !data.SignInFrequency.Value.IsNull() {
+		value := data.SignInFrequency.Value.ValueInt32()
+		signInFrequency.SetValue(&value)
 	}
-	
+
 	if !data.SignInFrequency.FrequencyInterval.IsNull() {
-			freqInterval, err := models.ParseSignInFrequencyInterval(data.SignInFrequency.FrequencyInterval.ValueString())
-			if err != nil {
-					return nil, fmt.Errorf("error parsing sign-in frequency interval: %v", err)
-			}
-			signInFrequency.SetFrequencyInterval(freqInterval)
+		freqInterval, err := models.ParseSignInFrequencyInterval(data.SignInFrequency.FrequencyInterval.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing sign-in frequency interval: %v", err)
+		}
+		signInFrequency.SetFrequencyInterval(freqInterval)
 	}
-	
+
 	if !data.SignInFrequency.AuthenticationType.IsNull() {
-			authType, err := models.ParseSignInFrequencyAuthenticationType(data.SignInFrequency.AuthenticationType.ValueString())
-			if err != nil {
-					return nil, fmt.Errorf("error parsing sign-in frequency authentication type: %v", err)
-			}
-			signInFrequency.SetAuthenticationType(authType)
+		authType, err := models.ParseSignInFrequencyAuthenticationType(data.SignInFrequency.AuthenticationType.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing sign-in frequency authentication type: %v", err)
+		}
+		signInFrequency.SetAuthenticationType(authType)
 	}
-	
+
 	sessionControls.SetSignInFrequency(signInFrequency)
 }
 
@@ -0,0 +1,245 @@
+package graphBetaConditionalAccessPolicy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// deviceFilterAttributes is the set of device properties Graph accepts on
+// the left-hand side of a conditional access device filter rule clause, e.g.
+// "device.trustType -eq \"AzureAD\"". See:
+// https://learn.microsoft.com/en-us/entra/identity/conditional-access/concept-condition-filters-for-devices
+var deviceFilterAttributes = func() map[string]bool {
+	attrs := map[string]bool{
+		"device.deviceOwnership":        true,
+		"device.trustType":              true,
+		"device.isCompliant":            true,
+		"device.manufacturer":           true,
+		"device.model":                  true,
+		"device.operatingSystem":        true,
+		"device.operatingSystemVersion": true,
+		"device.physicalIds":            true,
+		"device.displayName":            true,
+	}
+	for i := 1; i <= 15; i++ {
+		attrs[fmt.Sprintf("device.extensionAttribute%d", i)] = true
+	}
+	return attrs
+}()
+
+// deviceFilterOperators is the set of comparison operators a device filter
+// rule clause may use. The tokenizer takes the first entry that matches at
+// the current position; no entry is a prefix of another, so the order of
+// the entries does not change which operator is matched.
+var deviceFilterOperators = []string{
+	"-startsWith", "-endsWith", "-notContains", "-notIn", "-contains", "-in", "-eq", "-ne",
+}
+
+// deviceFilterTokenKind identifies the lexical class of a deviceFilterToken.
+type deviceFilterTokenKind int
+
+const (
+	deviceFilterTokenEOF deviceFilterTokenKind = iota
+	deviceFilterTokenIdent
+	deviceFilterTokenString
+	deviceFilterTokenOperator
+	deviceFilterTokenLParen
+	deviceFilterTokenRParen
+	deviceFilterTokenAnd
+	deviceFilterTokenOr
+	deviceFilterTokenNot
+)
+
+// deviceFilterToken is one lexical unit of a device filter rule, carrying
+// its 1-based rune offset so parse errors can point at the offending token.
+type deviceFilterToken struct {
+	kind  deviceFilterTokenKind
+	value string
+	pos   int
+}
+
+// lexDeviceFilterRule tokenizes a device filter rule string, returning a
+// descriptive error with the offending rune position on the first
+// unrecognized character or unterminated string literal.
+func lexDeviceFilterRule(rule string) ([]deviceFilterToken, error) {
+	var tokens []deviceFilterToken
+	runes := []rune(rule)
+	i := 0
+
+	for i < len(runes) {
+		c := runes[i]
+
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+			i++
+			continue
+		}
+
+		start := i + 1
+
+		switch {
+		case c == '(':
+			tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenLParen, value: "(", pos: start})
+			i++
+		case c == ')':
+			tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenRParen, value: ")", pos: start})
+			i++
+		case c == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				j++
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string literal starting at position %d", start)
+			}
+			tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenString, value: string(runes[i+1 : j]), pos: start})
+			i = j + 1
+		case c == '-':
+			matched := ""
+			for _, op := range deviceFilterOperators {
+				if i+len(op) <= len(runes) && string(runes[i:i+len(op)]) == op {
+					matched = op
+					break
+				}
+			}
+			if matched == "" {
+				return nil, fmt.Errorf("unrecognized operator at position %d", start)
+			}
+			tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenOperator, value: matched, pos: start})
+			i += len(matched)
+		case isDeviceFilterIdentRune(c):
+			j := i
+			for j < len(runes) && isDeviceFilterIdentRune(runes[j]) {
+				j++
+			}
+			word := string(runes[i:j])
+			switch word {
+			case "and":
+				tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenAnd, value: word, pos: start})
+			case "or":
+				tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenOr, value: word, pos: start})
+			case "not":
+				tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenNot, value: word, pos: start})
+			default:
+				tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenIdent, value: word, pos: start})
+			}
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, start)
+		}
+	}
+
+	tokens = append(tokens, deviceFilterToken{kind: deviceFilterTokenEOF, value: "", pos: len(runes) + 1})
+	return tokens, nil
+}
+
+func isDeviceFilterIdentRune(c rune) bool {
+	return c == '.' || c == '_' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// deviceFilterParser parses the token stream produced by
+// lexDeviceFilterRule against the grammar:
+//
+//	expression := term (("and" | "or") term)*
+//	term       := "not"? primary
+//	primary    := "(" expression ")" | clause
+//	clause     := attribute operator STRING
+type deviceFilterParser struct {
+	tokens []deviceFilterToken
+	pos    int
+}
+
+func (p *deviceFilterParser) peek() deviceFilterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *deviceFilterParser) advance() deviceFilterToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != deviceFilterTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *deviceFilterParser) expression() error {
+	if err := p.term(); err != nil {
+		return err
+	}
+	for p.peek().kind == deviceFilterTokenAnd || p.peek().kind == deviceFilterTokenOr {
+		p.advance()
+		if err := p.term(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *deviceFilterParser) term() error {
+	if p.peek().kind == deviceFilterTokenNot {
+		p.advance()
+	}
+	return p.primary()
+}
+
+func (p *deviceFilterParser) primary() error {
+	if p.peek().kind == deviceFilterTokenLParen {
+		p.advance()
+		if err := p.expression(); err != nil {
+			return err
+		}
+		closing := p.advance()
+		if closing.kind != deviceFilterTokenRParen {
+			return fmt.Errorf("expected ')' at position %d, found %q", closing.pos, closing.value)
+		}
+		return nil
+	}
+	return p.clause()
+}
+
+func (p *deviceFilterParser) clause() error {
+	attr := p.advance()
+	if attr.kind != deviceFilterTokenIdent {
+		return fmt.Errorf("expected a device attribute at position %d, found %q", attr.pos, attr.value)
+	}
+	if !deviceFilterAttributes[attr.value] {
+		return fmt.Errorf("unsupported device attribute %q at position %d", attr.value, attr.pos)
+	}
+
+	operator := p.advance()
+	if operator.kind != deviceFilterTokenOperator {
+		return fmt.Errorf("expected an operator at position %d, found %q", operator.pos, operator.value)
+	}
+
+	value := p.advance()
+	if value.kind != deviceFilterTokenString {
+		return fmt.Errorf("expected a quoted string value at position %d, found %q", value.pos, value.value)
+	}
+
+	return nil
+}
+
+// validateDeviceFilterRule parses rule against the device filter grammar
+// Graph accepts for conditions.devices.filter.rule, returning an error that
+// names the offending token and its position when the rule is malformed or
+// references an unsupported attribute or operator.
+func validateDeviceFilterRule(rule string) error {
+	if strings.TrimSpace(rule) == "" {
+		return fmt.Errorf("device filter rule must not be empty")
+	}
+
+	tokens, err := lexDeviceFilterRule(rule)
+	if err != nil {
+		return err
+	}
+
+	parser := &deviceFilterParser{tokens: tokens}
+	if err := parser.expression(); err != nil {
+		return err
+	}
+
+	if trailing := parser.peek(); trailing.kind != deviceFilterTokenEOF {
+		return fmt.Errorf("unexpected token %q at position %d", trailing.value, trailing.pos)
+	}
+
+	return nil
+}
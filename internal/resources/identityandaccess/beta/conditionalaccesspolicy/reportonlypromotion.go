@@ -0,0 +1,105 @@
+package graphBetaConditionalAccessPolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	"github.com/microsoftgraph/msgraph-beta-sdk-go/auditlogs"
+)
+
+// reportOnlyThenEnableState is the `state` value this provider accepts in
+// addition to Graph's own enabled/disabled/enabledForReportingButNotEnforced
+// values. A policy written with this state is always sent to Graph as
+// enabledForReportingButNotEnforced; see constructResource. Promotion to
+// enabled is decided by EvaluateReportOnlyPromotion, once the bake period
+// named in report_only_bake_period has elapsed with no sign-in failures
+// attributed to the policy.
+const reportOnlyThenEnableState = "report_only_then_enable"
+
+// ReportOnlyPromotionDecision is the outcome of evaluating whether a
+// report_only_then_enable policy is ready to be promoted to state =
+// enabled. RemainingBake and ObservedFailures say why a policy is not
+// ready; no resource in this provider calls EvaluateReportOnlyPromotion, so
+// they do not appear in plan output.
+type ReportOnlyPromotionDecision struct {
+	ReadyToPromote   bool
+	RemainingBake    time.Duration
+	ObservedFailures int
+}
+
+// EvaluateReportOnlyPromotion decides whether a policy staged with
+// state = report_only_then_enable has finished baking and had no sign-in
+// logs recording conditionalAccessStatus = failure for it during the bake
+// window, so a subsequent apply (or a scheduled drift run) can promote it
+// to state = enabled.
+//
+// bakeStartedAt is the timestamp recorded when the policy was first written
+// with enabledForReportingButNotEnforced. This provider has no
+// Create/Update resource methods yet to persist that timestamp to private
+// state (see construct.go - this package has no resource/schema scaffolding
+// yet), so callers currently have to track and supply it themselves; once
+// the scaffolding lands, Create/Update should stash it in
+// resp.Private and Read should pass it in here unchanged.
+func EvaluateReportOnlyPromotion(
+	ctx context.Context,
+	client *msgraphbetasdk.GraphServiceClient,
+	policyID string,
+	bakeStartedAt time.Time,
+	bakePeriod time.Duration,
+	now time.Time,
+) (*ReportOnlyPromotionDecision, error) {
+	elapsed := now.Sub(bakeStartedAt)
+	remaining := bakePeriod - elapsed
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	failures, err := countConditionalAccessSignInFailures(ctx, client, policyID, bakeStartedAt, now)
+	if err != nil {
+		return nil, fmt.Errorf("error counting sign-in failures for policy %s: %w", policyID, err)
+	}
+
+	return &ReportOnlyPromotionDecision{
+		ReadyToPromote:   remaining == 0 && failures == 0,
+		RemainingBake:    remaining,
+		ObservedFailures: failures,
+	}, nil
+}
+
+// countConditionalAccessSignInFailures counts sign-ins between since and
+// until that Graph recorded as conditionalAccessStatus = failure with this
+// policy ID among the applied conditional access policies. It uses the
+// @odata.count Graph returns for the filter when present; otherwise only
+// the sign-ins on the first page of results are counted.
+func countConditionalAccessSignInFailures(
+	ctx context.Context,
+	client *msgraphbetasdk.GraphServiceClient,
+	policyID string,
+	since time.Time,
+	until time.Time,
+) (int, error) {
+	filter := fmt.Sprintf(
+		"createdDateTime ge %s and createdDateTime le %s and conditionalAccessStatus eq 'failure' and appliedConditionalAccessPolicies/any(p:p/id eq '%s')",
+		since.UTC().Format(time.RFC3339), until.UTC().Format(time.RFC3339), policyID,
+	)
+	count := true
+
+	requestConfig := &auditlogs.SignInsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &auditlogs.SignInsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Count:  &count,
+		},
+	}
+
+	result, err := client.AuditLogs().SignIns().Get(ctx, requestConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	if total := result.GetOdataCount(); total != nil {
+		return int(*total), nil
+	}
+	return len(result.GetValue()), nil
+}
@@ -0,0 +1,99 @@
+package graphBetaConditionalAccessPolicy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// signInFrequencyDurationPattern matches the duration shorthand
+// sign_in_frequency.duration accepts: a positive integer followed by "h"
+// (hours) or "d" (days), e.g. "4h", "30d".
+var signInFrequencyDurationPattern = regexp.MustCompile(`^([0-9]+)(h|d)$`)
+
+// parseSignInFrequencyDuration expands a duration shorthand like "4h" or
+// "30d" into the (value, type) pair Graph's signInFrequency session control
+// expects.
+func parseSignInFrequencyDuration(duration string) (int32, string, error) {
+	match := signInFrequencyDurationPattern.FindStringSubmatch(duration)
+	if match == nil {
+		return 0, "", fmt.Errorf(`%q is not a valid duration; expected an integer followed by "h" (hours) or "d" (days), e.g. "4h" or "30d"`, duration)
+	}
+
+	value, err := strconv.ParseInt(match[1], 10, 32)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid duration value %q: %v", match[1], err)
+	}
+
+	switch match[2] {
+	case "h":
+		return int32(value), "hours", nil
+	case "d":
+		return int32(value), "days", nil
+	default:
+		return 0, "", fmt.Errorf("unreachable duration unit %q", match[2])
+	}
+}
+
+// formatSignInFrequencyDuration is the inverse of
+// parseSignInFrequencyDuration, used when mapping a remote signInFrequency
+// session control back to the duration shorthand.
+func formatSignInFrequencyDuration(value int32, freqType string) (string, error) {
+	switch freqType {
+	case "hours":
+		return fmt.Sprintf("%dh", value), nil
+	case "days":
+		return fmt.Sprintf("%dd", value), nil
+	default:
+		return "", fmt.Errorf("unsupported sign-in frequency type %q", freqType)
+	}
+}
+
+// validateSignInFrequency enforces the mutual-exclusion and value-range
+// rules Graph applies to session_controls.sign_in_frequency:
+//   - value and type must be omitted when frequency_interval = "everyTime"
+//   - authentication_type = "primaryAndSecondaryAuthentication" requires value
+//   - value must be 1-365 when type = "days", or 1-8760 when type = "hours"
+//
+// This belongs on a resource-level ConfigValidator so it runs at plan time,
+// but requires the Resource/Schema scaffolding this package doesn't have
+// yet (see construct.go). It's enforced here in the meantime, mirroring
+// validateGrantOrSessionControls.
+func validateSignInFrequency(data *SignInFrequencyModel) error {
+	if data == nil {
+		return nil
+	}
+
+	everyTime := !data.FrequencyInterval.IsNull() && data.FrequencyInterval.ValueString() == "everyTime"
+
+	if everyTime {
+		if !data.Value.IsNull() || !data.Type.IsNull() {
+			return fmt.Errorf(`session_controls.sign_in_frequency.value and .type must be omitted when frequency_interval = "everyTime"`)
+		}
+		return nil
+	}
+
+	if !data.AuthenticationType.IsNull() &&
+		data.AuthenticationType.ValueString() == "primaryAndSecondaryAuthentication" &&
+		data.Value.IsNull() {
+		return fmt.Errorf(`session_controls.sign_in_frequency.value must be set when authentication_type = "primaryAndSecondaryAuthentication"`)
+	}
+
+	if data.Value.IsNull() || data.Type.IsNull() {
+		return nil
+	}
+
+	value := data.Value.ValueInt32()
+	switch data.Type.ValueString() {
+	case "days":
+		if value < 1 || value > 365 {
+			return fmt.Errorf("session_controls.sign_in_frequency.value must be between 1 and 365 when type = \"days\", got %d", value)
+		}
+	case "hours":
+		if value < 1 || value > 8760 {
+			return fmt.Errorf("session_controls.sign_in_frequency.value must be between 1 and 8760 when type = \"hours\", got %d", value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+// Package conditionalaccesspolicyevaluation provides a what-if / dry-run
+// data source for conditional access policies. It is gated behind the
+// provider's opt-in `conditional_access_whatif` attribute since the
+// `/identity/conditionalAccess/evaluate` endpoint it calls is a preview
+// Graph API with no typed SDK builder, evaluated here via the same
+// ad-hoc HTTP request pattern as the graphquery data sources.
+//
+// REF: https://learn.microsoft.com/en-us/graph/api/conditionalaccessroot-evaluate?view=graph-rest-beta
+package graphBetaConditionalAccessPolicyEvaluation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ConditionalAccessPolicyEvaluationDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConditionalAccessPolicyEvaluationDataSource{}
+)
+
+// NewConditionalAccessPolicyEvaluationDataSource returns the
+// `microsoft365_graph_beta_conditional_access_policy_evaluation` data
+// source.
+func NewConditionalAccessPolicyEvaluationDataSource() datasource.DataSource {
+	return &ConditionalAccessPolicyEvaluationDataSource{}
+}
+
+// ConditionalAccessPolicyEvaluationDataSource evaluates a hypothetical
+// sign-in against the tenant's conditional access policies, surfacing which
+// grant/session controls would apply before an operator applies a policy
+// change.
+type ConditionalAccessPolicyEvaluationDataSource struct {
+	httpClient  *http.Client
+	credential  azcore.TokenCredential
+	apiScope    string
+	serviceRoot string
+	whatIfOn    bool
+}
+
+// ConditionalAccessPolicyEvaluationDataSourceModel describes the
+// `microsoft365_graph_beta_conditional_access_policy_evaluation` data
+// source.
+type ConditionalAccessPolicyEvaluationDataSourceModel struct {
+	UserID             types.String   `tfsdk:"user_id"`
+	AppID              types.String   `tfsdk:"app_id"`
+	ClientAppType      types.String   `tfsdk:"client_app_type"`
+	DevicePlatform     types.String   `tfsdk:"device_platform"`
+	SignInRiskLevel    types.String   `tfsdk:"sign_in_risk_level"`
+	IPAddress          types.String   `tfsdk:"ip_address"`
+	ApplicablePolicies []types.String `tfsdk:"applicable_policies"`
+	GrantControls      []types.String `tfsdk:"grant_controls"`
+	SessionControls    []types.String `tfsdk:"session_controls"`
+	Conflicts          []types.String `tfsdk:"conflicts"`
+}
+
+func (d *ConditionalAccessPolicyEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_beta_conditional_access_policy_evaluation"
+}
+
+func (d *ConditionalAccessPolicyEvaluationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GraphClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.httpClient = clients.HTTPClient
+	d.credential = clients.Credential
+	d.apiScope = clients.APIScope
+	d.serviceRoot = clients.GraphBetaServiceRoot
+	d.whatIfOn = clients.ConditionalAccessWhatIf
+}
+
+func (d *ConditionalAccessPolicyEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates a hypothetical sign-in against the tenant's conditional access policies via the " +
+			"`/identity/conditionalAccess/evaluate` preview endpoint, surfacing which grant/session controls would " +
+			"apply and any reported conflicts between policies, before an operator applies a policy change. Requires " +
+			"the provider's `conditional_access_whatif` attribute to be set to `true`.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The object ID of the user to evaluate the sign-in context for.",
+			},
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The application ID (client ID) being signed in to.",
+			},
+			"client_app_type": schema.StringAttribute{
+				Optional: true,
+				Description: "The client app type for the sign-in context, e.g. `browser`, `mobileAppsAndDesktopClients`, " +
+					"`exchangeActiveSync`, `other`.",
+			},
+			"device_platform": schema.StringAttribute{
+				Optional:    true,
+				Description: "The device platform for the sign-in context, e.g. `windows`, `iOS`, `android`, `macOS`.",
+			},
+			"sign_in_risk_level": schema.StringAttribute{
+				Optional:    true,
+				Description: "The sign-in risk level for the sign-in context, e.g. `low`, `medium`, `high`, `none`.",
+			},
+			"ip_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "The originating IP address for the sign-in context, used to evaluate named location conditions.",
+			},
+			"applicable_policies": schema.ListAttribute{
+				Computed:    true,
+				Description: "The display names of the conditional access policies that matched this sign-in context.",
+				ElementType: types.StringType,
+			},
+			"grant_controls": schema.ListAttribute{
+				Computed:    true,
+				Description: "The grant controls that would be enforced by the matched policies, e.g. `mfa`, `compliantDevice`.",
+				ElementType: types.StringType,
+			},
+			"session_controls": schema.ListAttribute{
+				Computed:    true,
+				Description: "The session controls that would be enforced by the matched policies, e.g. `signInFrequency`.",
+				ElementType: types.StringType,
+			},
+			"conflicts": schema.ListAttribute{
+				Computed: true,
+				Description: "Human-readable descriptions of conflicts Graph reported between the matched policies, " +
+					"e.g. one policy blocking access while another grants it for the same sign-in context.",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// evaluationResult is the subset of the evaluate endpoint's response body
+// this data source surfaces.
+type evaluationResult struct {
+	ApplicablePolicies []struct {
+		DisplayName string `json:"displayName"`
+	} `json:"applicablePolicies"`
+	GrantControls   []string `json:"grantControls"`
+	SessionControls []string `json:"sessionControls"`
+	Conflicts       []string `json:"conflicts"`
+}
+
+func (d *ConditionalAccessPolicyEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.whatIfOn {
+		resp.Diagnostics.AddError(
+			"Conditional access what-if evaluation is disabled",
+			"The provider's conditional_access_whatif attribute must be set to true to use "+
+				"microsoft365_graph_beta_conditional_access_policy_evaluation.",
+		)
+		return
+	}
+
+	var data ConditionalAccessPolicyEvaluationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestBody := map[string]interface{}{
+		"userId": data.UserID.ValueString(),
+		"appId":  data.AppID.ValueString(),
+	}
+	if !data.ClientAppType.IsNull() {
+		requestBody["clientAppType"] = data.ClientAppType.ValueString()
+	}
+	if !data.DevicePlatform.IsNull() {
+		requestBody["devicePlatform"] = data.DevicePlatform.ValueString()
+	}
+	if !data.SignInRiskLevel.IsNull() {
+		requestBody["signInRiskLevel"] = data.SignInRiskLevel.ValueString()
+	}
+	if !data.IPAddress.IsNull() {
+		requestBody["ipAddress"] = data.IPAddress.ValueString()
+	}
+
+	result, err := d.evaluate(ctx, requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError("Error evaluating conditional access policies", err.Error())
+		return
+	}
+
+	applicablePolicies := make([]types.String, 0, len(result.ApplicablePolicies))
+	for _, p := range result.ApplicablePolicies {
+		applicablePolicies = append(applicablePolicies, types.StringValue(p.DisplayName))
+	}
+	data.ApplicablePolicies = applicablePolicies
+
+	grantControls := make([]types.String, 0, len(result.GrantControls))
+	for _, c := range result.GrantControls {
+		grantControls = append(grantControls, types.StringValue(c))
+	}
+	data.GrantControls = grantControls
+
+	sessionControls := make([]types.String, 0, len(result.SessionControls))
+	for _, c := range result.SessionControls {
+		sessionControls = append(sessionControls, types.StringValue(c))
+	}
+	data.SessionControls = sessionControls
+
+	conflicts := make([]types.String, 0, len(result.Conflicts))
+	for _, c := range result.Conflicts {
+		conflicts = append(conflicts, types.StringValue(c))
+	}
+	data.Conflicts = conflicts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// evaluate POSTs a sign-in context to /identity/conditionalAccess/evaluate
+// and decodes the response.
+func (d *ConditionalAccessPolicyEvaluationDataSource) evaluate(ctx context.Context, requestBody map[string]interface{}) (*evaluationResult, error) {
+	encoded, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request body: %w", err)
+	}
+
+	url := d.serviceRoot + "/identity/conditionalAccess/evaluate"
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	token, err := d.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{d.apiScope}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain access token: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+token.Token)
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	tflog.Debug(ctx, fmt.Sprintf("Evaluating conditional access policies: POST %s", url))
+
+	httpResp, err := d.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	var result evaluationResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,40 @@
+package graphBetaConditionalAccessPolicyEvaluation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Registration implements provider.ServiceRegistration for the conditional
+// access policy what-if evaluation subsystem.
+//
+// The request this subsystem implements also asks for the evaluator to run
+// automatically on `terraform plan` when `conditional_access_whatif` is on,
+// attaching non-fatal warnings for any policy whose state transitions
+// enabled->disabled or whose conditions newly exclude break-glass accounts.
+// That belongs on the conditional access policy resource's ModifyPlan, but
+// identityandaccess/beta/conditionalaccesspolicy has no resource/schema
+// scaffolding yet (see construct.go in that package) to hang a
+// ResourceWithModifyPlan implementation off of. Until that scaffolding
+// lands, operators can only opt into the dry-run manually via this data
+// source.
+type Registration struct{}
+
+// Name returns the stable identifier used for registration ordering and
+// duplicate detection.
+func (Registration) Name() string {
+	return "identity_and_access/conditional_access_policy_evaluation"
+}
+
+// Resources returns no resources; this subsystem only exposes a data
+// source.
+func (Registration) Resources() []func() resource.Resource {
+	return nil
+}
+
+// DataSources returns the conditional access policy evaluation data source.
+func (Registration) DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewConditionalAccessPolicyEvaluationDataSource,
+	}
+}
@@ -0,0 +1,131 @@
+// Package providerconfig exposes the provider's already-resolved
+// configuration as a data source, so downstream modules can branch on it
+// and acceptance tests can assert env-var vs HCL precedence without
+// reflecting on credentials directly.
+package providerconfig
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ProviderConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProviderConfigDataSource{}
+)
+
+// NewProviderConfigDataSource returns the `microsoft365_provider_config`
+// data source.
+func NewProviderConfigDataSource() datasource.DataSource {
+	return &ProviderConfigDataSource{}
+}
+
+// ProviderConfigDataSource surfaces the provider's resolved, non-secret
+// configuration. It reads from the already-populated client.GraphClients
+// set up in Configure; it never re-runs authentication.
+type ProviderConfigDataSource struct {
+	clients *client.GraphClients
+}
+
+// ProviderConfigDataSourceModel is read-only: every attribute is Computed
+// from client.GraphClients, so a bare `data` block with no arguments is
+// enough to read it.
+type ProviderConfigDataSourceModel struct {
+	Cloud                types.String `tfsdk:"cloud"`
+	AuthorityURL         types.String `tfsdk:"authority_url"`
+	GraphServiceRoot     types.String `tfsdk:"graph_service_root"`
+	GraphBetaServiceRoot types.String `tfsdk:"graph_beta_service_root"`
+	AuthMethod           types.String `tfsdk:"auth_method"`
+	TenantID             types.String `tfsdk:"tenant_id"`
+	ClientID             types.String `tfsdk:"client_id"`
+	UseProxy             types.Bool   `tfsdk:"use_proxy"`
+	ProviderVersion      types.String `tfsdk:"provider_version"`
+}
+
+func (d *ProviderConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_config"
+}
+
+func (d *ProviderConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*client.GraphClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.GraphClients, got a different type. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.clients = clients
+}
+
+func (d *ProviderConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Surfaces the provider's resolved, non-secret configuration: effective cloud, Graph endpoints, " +
+			"auth method, tenant/client IDs, proxy usage, and provider version. Useful for downstream modules that " +
+			"need to branch on cloud, and for acceptance tests asserting that env-var vs HCL precedence in the " +
+			"provider block resolved as expected.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Computed:    true,
+				Description: "The effective `cloud` provider attribute, after environment variable resolution.",
+			},
+			"authority_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Entra ID authority host credentials were obtained from.",
+			},
+			"graph_service_root": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Microsoft Graph v1.0 service root the provider is sending requests to.",
+			},
+			"graph_beta_service_root": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Microsoft Graph beta service root the provider is sending requests to.",
+			},
+			"auth_method": schema.StringAttribute{
+				Computed:    true,
+				Description: "The effective `auth_method` provider attribute, after environment variable resolution.",
+			},
+			"tenant_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The effective `tenant_id` provider attribute, after environment variable resolution.",
+			},
+			"client_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The effective `client_id` provider attribute, after environment variable resolution.",
+			},
+			"use_proxy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the provider is routing Graph requests through a configured HTTP proxy.",
+			},
+			"provider_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The provider version string.",
+			},
+		},
+	}
+}
+
+func (d *ProviderConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := ProviderConfigDataSourceModel{
+		Cloud:                types.StringValue(d.clients.Cloud),
+		AuthorityURL:         types.StringValue(d.clients.AuthorityURL),
+		GraphServiceRoot:     types.StringValue(d.clients.GraphServiceRoot),
+		GraphBetaServiceRoot: types.StringValue(d.clients.GraphBetaServiceRoot),
+		AuthMethod:           types.StringValue(d.clients.AuthMethod),
+		TenantID:             types.StringValue(d.clients.TenantID),
+		ClientID:             types.StringValue(d.clients.ClientID),
+		UseProxy:             types.BoolValue(d.clients.UseProxy),
+		ProviderVersion:      types.StringValue(d.clients.ProviderVersion),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
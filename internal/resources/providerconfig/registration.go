@@ -0,0 +1,29 @@
+package providerconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Registration implements provider.ServiceRegistration for the
+// provider-config data source.
+type Registration struct{}
+
+// Name returns the stable identifier used for registration ordering and
+// duplicate detection.
+func (Registration) Name() string {
+	return "providerconfig"
+}
+
+// Resources returns no resources; this subsystem only exposes a data
+// source.
+func (Registration) Resources() []func() resource.Resource {
+	return nil
+}
+
+// DataSources returns the provider-config data source.
+func (Registration) DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewProviderConfigDataSource,
+	}
+}
@@ -0,0 +1,41 @@
+package rulelang
+
+// Node is implemented by every AST node produced by the Parser.
+type Node interface {
+	node()
+}
+
+// BinaryExpr is a boolean composition of two expressions joined by "and" or
+// "or".
+type BinaryExpr struct {
+	Op    string // "and" | "or"
+	Left  Node
+	Right Node
+}
+
+// NotExpr negates its operand.
+type NotExpr struct {
+	Operand Node
+}
+
+// Comparison is a single `property operator literal` clause, e.g.
+// `device.deviceName -startsWith "CORP-"`.
+type Comparison struct {
+	Property string
+	Operator string
+	Literal  Literal
+	Line     int
+	Column   int
+}
+
+// Literal is the right-hand side of a Comparison.
+type Literal struct {
+	// Kind is one of "string", "collection".
+	Kind   string
+	String string
+	Values []string
+}
+
+func (*BinaryExpr) node() {}
+func (*NotExpr) node()    {}
+func (*Comparison) node() {}
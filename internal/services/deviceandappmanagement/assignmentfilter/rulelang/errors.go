@@ -0,0 +1,21 @@
+package rulelang
+
+import "fmt"
+
+// SyntaxError describes a single parse failure, including the offending
+// token's position and what would have been accepted instead, so callers
+// (the Terraform schema validator) can surface actionable diagnostics.
+type SyntaxError struct {
+	Line     int
+	Column   int
+	Token    string
+	Message  string
+	Expected string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Expected == "" {
+		return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s (expected %s)", e.Line, e.Column, e.Message, e.Expected)
+}
@@ -0,0 +1,32 @@
+package rulelang
+
+import "testing"
+
+// FuzzParse seeds from the rules listed below, both well-formed and
+// intentionally malformed, and asserts the parser never panics, regardless
+// of input. The .rule files under testdata/fuzz are not read by this test.
+func FuzzParse(f *testing.F) {
+	seeds := []string{
+		`device.deviceName -startsWith "CORP-" and device.osVersion -gt "10.0"`,
+		`app.appVersion -in ["1.0.0", "1.0.1"] or not device.isRooted -eq "true"`,
+		`device.deviceOwnership -eq "Corporate"`,
+		`(device.manufacturer -in ["Dell Inc.", "HP"]) and not (device.deviceType -eq "Desktop")`,
+		``,
+		`device.deviceName`,
+		`device.deviceName -eq`,
+		`device.deviceName -eq "unterminated`,
+		`-eq "no property"`,
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, rule string) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("Parse(%q) panicked: %v", rule, r)
+			}
+		}()
+		_, _ = Parse(rule)
+	})
+}
@@ -0,0 +1,183 @@
+package rulelang
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Lexer tokenizes an Intune assignment filter rule string.
+type Lexer struct {
+	input  string
+	pos    int
+	line   int
+	column int
+}
+
+// NewLexer returns a Lexer ready to tokenize input.
+func NewLexer(input string) *Lexer {
+	return &Lexer{input: input, line: 1, column: 1}
+}
+
+// Tokenize consumes the entire input and returns the resulting token stream,
+// terminated by a TokenEOF. It returns a *SyntaxError on the first
+// unrecognized character.
+func (l *Lexer) Tokenize() ([]Token, error) {
+	var tokens []Token
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, tok)
+		if tok.Kind == TokenEOF {
+			return tokens, nil
+		}
+	}
+}
+
+func (l *Lexer) next() (Token, error) {
+	l.skipWhitespace()
+
+	line, column := l.line, l.column
+
+	if l.pos >= len(l.input) {
+		return Token{Kind: TokenEOF, Line: line, Column: column}, nil
+	}
+
+	ch := l.input[l.pos]
+
+	switch {
+	case ch == '(':
+		l.advance(1)
+		return Token{Kind: TokenLParen, Value: "(", Line: line, Column: column}, nil
+	case ch == ')':
+		l.advance(1)
+		return Token{Kind: TokenRParen, Value: ")", Line: line, Column: column}, nil
+	case ch == '[':
+		l.advance(1)
+		return Token{Kind: TokenLBracket, Value: "[", Line: line, Column: column}, nil
+	case ch == ']':
+		l.advance(1)
+		return Token{Kind: TokenRBracket, Value: "]", Line: line, Column: column}, nil
+	case ch == ',':
+		l.advance(1)
+		return Token{Kind: TokenComma, Value: ",", Line: line, Column: column}, nil
+	case ch == '"':
+		return l.lexString(line, column)
+	case ch == '-':
+		return l.lexOperator(line, column)
+	case unicode.IsDigit(rune(ch)):
+		return l.lexNumber(line, column), nil
+	case isIdentStart(ch):
+		return l.lexIdentOrKeyword(line, column), nil
+	default:
+		return Token{}, &SyntaxError{
+			Line:     line,
+			Column:   column,
+			Message:  fmt.Sprintf("unexpected character %q", ch),
+			Expected: "identifier, operator, literal or parenthesis",
+		}
+	}
+}
+
+func (l *Lexer) skipWhitespace() {
+	for l.pos < len(l.input) {
+		ch := l.input[l.pos]
+		if ch == '\n' {
+			l.pos++
+			l.line++
+			l.column = 1
+			continue
+		}
+		if unicode.IsSpace(rune(ch)) {
+			l.advance(1)
+			continue
+		}
+		break
+	}
+}
+
+func (l *Lexer) advance(n int) {
+	l.pos += n
+	l.column += n
+}
+
+func (l *Lexer) lexString(line, column int) (Token, error) {
+	start := l.pos
+	l.advance(1) // consume opening quote
+	var sb strings.Builder
+	for {
+		if l.pos >= len(l.input) {
+			return Token{}, &SyntaxError{
+				Line:     line,
+				Column:   column,
+				Message:  "unterminated string literal",
+				Expected: `closing '"'`,
+			}
+		}
+		ch := l.input[l.pos]
+		if ch == '"' {
+			l.advance(1)
+			break
+		}
+		if ch == '\\' && l.pos+1 < len(l.input) {
+			sb.WriteByte(l.input[l.pos+1])
+			l.advance(2)
+			continue
+		}
+		sb.WriteByte(ch)
+		l.advance(1)
+	}
+	_ = start
+	return Token{Kind: TokenString, Value: sb.String(), Line: line, Column: column}, nil
+}
+
+func (l *Lexer) lexNumber(line, column int) Token {
+	start := l.pos
+	for l.pos < len(l.input) && (unicode.IsDigit(rune(l.input[l.pos])) || l.input[l.pos] == '.') {
+		l.advance(1)
+	}
+	return Token{Kind: TokenNumber, Value: l.input[start:l.pos], Line: line, Column: column}
+}
+
+func (l *Lexer) lexOperator(line, column int) (Token, error) {
+	for _, op := range operators {
+		if strings.HasPrefix(l.input[l.pos:], op) {
+			l.advance(len(op))
+			return Token{Kind: TokenOperator, Value: op, Line: line, Column: column}, nil
+		}
+	}
+	return Token{}, &SyntaxError{
+		Line:     line,
+		Column:   column,
+		Message:  "unrecognized operator",
+		Expected: "one of -eq, -ne, -startsWith, -endsWith, -contains, -in, -notIn, -match, -gt, -lt",
+	}
+}
+
+func (l *Lexer) lexIdentOrKeyword(line, column int) Token {
+	start := l.pos
+	for l.pos < len(l.input) && isIdentPart(l.input[l.pos]) {
+		l.advance(1)
+	}
+	value := l.input[start:l.pos]
+	switch value {
+	case "and":
+		return Token{Kind: TokenAnd, Value: value, Line: line, Column: column}
+	case "or":
+		return Token{Kind: TokenOr, Value: value, Line: line, Column: column}
+	case "not":
+		return Token{Kind: TokenNot, Value: value, Line: line, Column: column}
+	default:
+		return Token{Kind: TokenIdent, Value: value, Line: line, Column: column}
+	}
+}
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || unicode.IsLetter(rune(ch))
+}
+
+func isIdentPart(ch byte) bool {
+	return ch == '_' || ch == '.' || unicode.IsLetter(rune(ch)) || unicode.IsDigit(rune(ch))
+}
@@ -0,0 +1,219 @@
+package rulelang
+
+import "fmt"
+
+// Parser builds an AST from a token stream using recursive descent, matching
+// the precedence `not` > `and` > `or` used by the Intune filter rule
+// grammar.
+type Parser struct {
+	tokens []Token
+	pos    int
+}
+
+// Parse tokenizes and parses an Intune assignment filter rule, returning its
+// AST root or the first *SyntaxError encountered.
+func Parse(input string) (Node, error) {
+	tokens, err := NewLexer(input).Tokenize()
+	if err != nil {
+		return nil, err
+	}
+	p := &Parser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.Kind != TokenEOF {
+		return nil, &SyntaxError{
+			Line:     tok.Line,
+			Column:   tok.Column,
+			Message:  fmt.Sprintf("unexpected %s %q", tok.Kind, tok.Value),
+			Expected: "'and', 'or' or end of rule",
+		}
+	}
+	return node, nil
+}
+
+func (p *Parser) peek() Token {
+	if p.pos >= len(p.tokens) {
+		return Token{Kind: TokenEOF}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *Parser) advance() Token {
+	tok := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *Parser) parseOr() (Node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().Kind == TokenOr {
+		p.advance()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *Parser) parseAnd() (Node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().Kind == TokenAnd {
+		p.advance()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *Parser) parseUnary() (Node, error) {
+	if p.peek().Kind == TokenNot {
+		p.advance()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *Parser) parsePrimary() (Node, error) {
+	tok := p.peek()
+
+	if tok.Kind == TokenLParen {
+		p.advance()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().Kind != TokenRParen {
+			got := p.peek()
+			return nil, &SyntaxError{
+				Line:     got.Line,
+				Column:   got.Column,
+				Message:  fmt.Sprintf("unexpected %s %q", got.Kind, got.Value),
+				Expected: "')'",
+			}
+		}
+		p.advance()
+		return expr, nil
+	}
+
+	if tok.Kind != TokenIdent {
+		return nil, &SyntaxError{
+			Line:     tok.Line,
+			Column:   tok.Column,
+			Message:  fmt.Sprintf("unexpected %s %q", tok.Kind, tok.Value),
+			Expected: "property identifier, 'not' or '('",
+		}
+	}
+	property := p.advance()
+
+	opTok := p.peek()
+	if opTok.Kind != TokenOperator {
+		return nil, &SyntaxError{
+			Line:     opTok.Line,
+			Column:   opTok.Column,
+			Message:  fmt.Sprintf("unexpected %s %q", opTok.Kind, opTok.Value),
+			Expected: "a comparison operator (e.g. -eq, -contains, -in)",
+		}
+	}
+	p.advance()
+
+	literal, err := p.parseLiteral(opTok)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Comparison{
+		Property: property.Value,
+		Operator: opTok.Value,
+		Literal:  literal,
+		Line:     property.Line,
+		Column:   property.Column,
+	}, nil
+}
+
+func (p *Parser) parseLiteral(opTok Token) (Literal, error) {
+	switch opTok.Value {
+	case "-in", "-notIn":
+		return p.parseCollection()
+	default:
+		tok := p.peek()
+		if tok.Kind != TokenString {
+			return Literal{}, &SyntaxError{
+				Line:     tok.Line,
+				Column:   tok.Column,
+				Message:  fmt.Sprintf("unexpected %s %q", tok.Kind, tok.Value),
+				Expected: "a quoted string literal",
+			}
+		}
+		p.advance()
+		return Literal{Kind: "string", String: tok.Value}, nil
+	}
+}
+
+func (p *Parser) parseCollection() (Literal, error) {
+	open := p.peek()
+	if open.Kind != TokenLBracket {
+		return Literal{}, &SyntaxError{
+			Line:     open.Line,
+			Column:   open.Column,
+			Message:  fmt.Sprintf("unexpected %s %q", open.Kind, open.Value),
+			Expected: "'[' to start a collection literal",
+		}
+	}
+	p.advance()
+
+	var values []string
+	for {
+		if p.peek().Kind == TokenRBracket {
+			p.advance()
+			break
+		}
+		tok := p.peek()
+		if tok.Kind != TokenString {
+			return Literal{}, &SyntaxError{
+				Line:     tok.Line,
+				Column:   tok.Column,
+				Message:  fmt.Sprintf("unexpected %s %q", tok.Kind, tok.Value),
+				Expected: "a quoted string literal inside the collection",
+			}
+		}
+		p.advance()
+		values = append(values, tok.Value)
+
+		next := p.peek()
+		if next.Kind == TokenComma {
+			p.advance()
+			continue
+		}
+		if next.Kind == TokenRBracket {
+			p.advance()
+			break
+		}
+		return Literal{}, &SyntaxError{
+			Line:     next.Line,
+			Column:   next.Column,
+			Message:  fmt.Sprintf("unexpected %s %q", next.Kind, next.Value),
+			Expected: "',' or ']'",
+		}
+	}
+
+	return Literal{Kind: "collection", Values: values}, nil
+}
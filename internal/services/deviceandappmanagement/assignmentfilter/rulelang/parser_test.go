@@ -0,0 +1,96 @@
+package rulelang
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readGolden(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "golden", name))
+	if err != nil {
+		t.Fatalf("reading golden file %s: %v", name, err)
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func TestParseGoldenValid(t *testing.T) {
+	tests := []string{
+		"valid_simple.rule",
+		"valid_compound.rule",
+		"valid_collection.rule",
+		"valid_not.rule",
+	}
+
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule := readGolden(t, name)
+			if _, err := Parse(rule); err != nil {
+				t.Fatalf("Parse(%q) returned unexpected error: %v", rule, err)
+			}
+		})
+	}
+}
+
+func TestParseGoldenInvalid(t *testing.T) {
+	tests := []string{
+		"invalid_missing_operator.rule",
+		"invalid_unterminated_string.rule",
+	}
+
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule := readGolden(t, name)
+			_, err := Parse(rule)
+			if err == nil {
+				t.Fatalf("Parse(%q) expected an error, got none", rule)
+			}
+			if _, ok := err.(*SyntaxError); !ok {
+				t.Fatalf("Parse(%q) error = %T, want *SyntaxError", rule, err)
+			}
+		})
+	}
+}
+
+func TestValidatePlatformWhitelist(t *testing.T) {
+	err := Validate(`device.androidPatchLevel -eq "2023-01-01"`, ValidateOptions{
+		Platform:       "iOS",
+		ManagementType: "devices",
+	})
+	if err == nil {
+		t.Fatal("expected an error for an androidForWork-only property on iOS")
+	}
+}
+
+func TestValidateAppsManagementTypeRejectsDeviceOnlyProperty(t *testing.T) {
+	err := Validate(`device.deviceName -eq "CORP-1"`, ValidateOptions{
+		Platform:       "windows10AndLater",
+		ManagementType: "apps",
+	})
+	if err == nil {
+		t.Fatal("expected an error for a device-only property with management type \"apps\"")
+	}
+}
+
+func TestValidateAllowsAppPropertyForApps(t *testing.T) {
+	err := Validate(`app.appVersion -gt "1.0.0"`, ValidateOptions{
+		Platform:       "windows10AndLater",
+		ManagementType: "apps",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSyntaxErrorReportsPosition(t *testing.T) {
+	_, err := Parse(`device.deviceName "CORP-"`)
+	syntaxErr, ok := err.(*SyntaxError)
+	if !ok {
+		t.Fatalf("error = %T, want *SyntaxError", err)
+	}
+	if syntaxErr.Line != 1 || syntaxErr.Column != 19 {
+		t.Fatalf("got line %d column %d, want line 1 column 19", syntaxErr.Line, syntaxErr.Column)
+	}
+}
@@ -0,0 +1,72 @@
+package rulelang
+
+// platformProperties enumerates the property identifiers Graph accepts for
+// each Intune assignment filter platform. Kept intentionally conservative -
+// new properties ship regularly, but rejecting a property early with a clear
+// diagnostic is better than a silent Graph-side 400.
+//
+// REF: https://learn.microsoft.com/en-us/mem/intune/fundamentals/filters
+var platformProperties = map[string]map[string]bool{
+	"windows10AndLater": setOf(
+		"device.deviceName", "device.manufacturer", "device.model",
+		"device.osVersion", "device.deviceOwnership", "device.deviceType",
+		"device.enrollmentProfileName", "device.enrollmentType",
+		"device.manufacturerModel", "device.managementChannel",
+		"device.deviceCategory", "device.extensionAttribute1", "device.extensionAttribute2",
+		"device.physicalIds", "device.skuFamily", "device.enrollmentTime",
+		"app.appVersion",
+	),
+	"iOS": setOf(
+		"device.deviceName", "device.manufacturer", "device.model",
+		"device.osVersion", "device.deviceOwnership", "device.deviceType",
+		"device.enrollmentProfileName", "device.deviceCategory",
+		"device.enrollmentType", "device.isSupervised", "device.isEncrypted",
+		"app.appVersion",
+	),
+	"androidForWork": setOf(
+		"device.deviceName", "device.manufacturer", "device.model",
+		"device.osVersion", "device.deviceOwnership", "device.deviceType",
+		"device.enrollmentProfileName", "device.deviceCategory",
+		"device.enrollmentType", "device.androidPatchLevel",
+		"device.androidDeviceManufacturer", "device.isRooted",
+		"app.appVersion",
+	),
+	"macOS": setOf(
+		"device.deviceName", "device.manufacturer", "device.model",
+		"device.osVersion", "device.deviceOwnership", "device.deviceType",
+		"device.enrollmentProfileName", "device.deviceCategory",
+		"device.enrollmentType", "app.appVersion",
+	),
+}
+
+// deviceOnlyProperties are meaningless when the filter is scoped to apps
+// (assignment_filter_management_type = "apps") because they describe the
+// managed device rather than the app being assigned.
+var deviceOnlyProperties = setOf(
+	"device.deviceName", "device.manufacturer", "device.model",
+	"device.osVersion", "device.deviceOwnership", "device.deviceType",
+	"device.enrollmentProfileName", "device.enrollmentType",
+	"device.manufacturerModel", "device.managementChannel",
+	"device.deviceCategory", "device.extensionAttribute1", "device.extensionAttribute2",
+	"device.physicalIds", "device.skuFamily", "device.enrollmentTime",
+	"device.isSupervised", "device.isEncrypted", "device.androidPatchLevel",
+	"device.androidDeviceManufacturer", "device.isRooted",
+)
+
+func setOf(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// SupportedPlatforms returns the platform identifiers this package knows a
+// property whitelist for.
+func SupportedPlatforms() []string {
+	platforms := make([]string, 0, len(platformProperties))
+	for platform := range platformProperties {
+		platforms = append(platforms, platform)
+	}
+	return platforms
+}
@@ -0,0 +1,82 @@
+// Package rulelang implements a lexer, parser and validator for the Intune
+// assignment filter rule grammar used by the `rule` attribute of
+// graph_beta_device_and_app_management_assignment_filter.
+package rulelang
+
+// TokenKind identifies the lexical category of a Token.
+type TokenKind int
+
+const (
+	TokenEOF TokenKind = iota
+	TokenIdent
+	TokenString
+	TokenNumber
+	TokenOperator
+	TokenLParen
+	TokenRParen
+	TokenComma
+	TokenLBracket
+	TokenRBracket
+	TokenAnd
+	TokenOr
+	TokenNot
+)
+
+// Token is a single lexical unit produced by the Lexer, tagged with the
+// 1-based line/column where it starts so the parser can produce precise
+// diagnostics.
+type Token struct {
+	Kind   TokenKind
+	Value  string
+	Line   int
+	Column int
+}
+
+func (k TokenKind) String() string {
+	switch k {
+	case TokenEOF:
+		return "end of input"
+	case TokenIdent:
+		return "identifier"
+	case TokenString:
+		return "string literal"
+	case TokenNumber:
+		return "number literal"
+	case TokenOperator:
+		return "operator"
+	case TokenLParen:
+		return "'('"
+	case TokenRParen:
+		return "')'"
+	case TokenComma:
+		return "','"
+	case TokenLBracket:
+		return "'['"
+	case TokenRBracket:
+		return "']'"
+	case TokenAnd:
+		return "'and'"
+	case TokenOr:
+		return "'or'"
+	case TokenNot:
+		return "'not'"
+	default:
+		return "unknown token"
+	}
+}
+
+// operators supported by the Intune filter rule grammar. The lexer takes the
+// first entry that prefixes the remaining input; no entry is a prefix of
+// another, so that match is the only one and no backtracking is needed.
+var operators = []string{
+	"-startsWith",
+	"-endsWith",
+	"-notIn",
+	"-contains",
+	"-match",
+	"-in",
+	"-eq",
+	"-ne",
+	"-gt",
+	"-lt",
+}
@@ -0,0 +1,70 @@
+package rulelang
+
+import "fmt"
+
+// ValidateOptions carries the context needed to apply the platform- and
+// management-type-specific semantic checks on top of syntax validation.
+type ValidateOptions struct {
+	// Platform is the assignment filter's `platform` value, e.g.
+	// "windows10AndLater". Unknown platforms skip the property whitelist
+	// check (forward-compatibility with new platforms Graph adds before we
+	// do).
+	Platform string
+
+	// ManagementType is the assignment filter's
+	// `assignment_filter_management_type` value ("devices" or "apps").
+	ManagementType string
+}
+
+// Validate parses rule and, if it parses successfully, applies the
+// platform property whitelist and the apps/device-only property check. It
+// returns the first *SyntaxError encountered either way.
+func Validate(rule string, opts ValidateOptions) error {
+	node, err := Parse(rule)
+	if err != nil {
+		return err
+	}
+	return validateNode(node, opts)
+}
+
+func validateNode(node Node, opts ValidateOptions) error {
+	switch n := node.(type) {
+	case *BinaryExpr:
+		if err := validateNode(n.Left, opts); err != nil {
+			return err
+		}
+		return validateNode(n.Right, opts)
+	case *NotExpr:
+		return validateNode(n.Operand, opts)
+	case *Comparison:
+		return validateComparison(n, opts)
+	default:
+		return fmt.Errorf("rulelang: unrecognized AST node %T", node)
+	}
+}
+
+func validateComparison(c *Comparison, opts ValidateOptions) error {
+	if allowed, ok := platformProperties[opts.Platform]; ok {
+		if !allowed[c.Property] {
+			return &SyntaxError{
+				Line:     c.Line,
+				Column:   c.Column,
+				Token:    c.Property,
+				Message:  fmt.Sprintf("property %q is not valid for platform %q", c.Property, opts.Platform),
+				Expected: "one of the properties supported by this platform",
+			}
+		}
+	}
+
+	if opts.ManagementType == "apps" && deviceOnlyProperties[c.Property] {
+		return &SyntaxError{
+			Line:     c.Line,
+			Column:   c.Column,
+			Token:    c.Property,
+			Message:  fmt.Sprintf("property %q is device-only and cannot be used when assignment_filter_management_type is \"apps\"", c.Property),
+			Expected: "an app.* property",
+		}
+	}
+
+	return nil
+}
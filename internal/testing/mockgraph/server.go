@@ -0,0 +1,210 @@
+// Package mockgraph provides an in-process httptest server that emulates the
+// subset of the Microsoft Graph `/deviceManagement/assignmentFilters`
+// endpoints exercised by graphBetaAssignmentFilter's acceptance tests, so
+// those tests can run hermetically instead of requiring TF_ACC against a
+// real tenant.
+package mockgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// Filter mirrors the subset of the Graph
+// deviceAndAppManagementAssignmentFilter resource that the provider reads
+// and writes.
+type Filter struct {
+	ID                             string                   `json:"id"`
+	DisplayName                    string                   `json:"displayName"`
+	Description                    string                   `json:"description,omitempty"`
+	Platform                       string                   `json:"platform"`
+	Rule                           string                   `json:"rule"`
+	AssignmentFilterManagementType string                   `json:"assignmentFilterManagementType,omitempty"`
+	CreatedDateTime                string                   `json:"createdDateTime"`
+	LastModifiedDateTime           string                   `json:"lastModifiedDateTime"`
+	RoleScopeTags                  []string                 `json:"roleScopeTags,omitempty"`
+	Payloads                       []map[string]interface{} `json:"payloads,omitempty"`
+}
+
+// Server is a mock Microsoft Graph server backed by an in-memory filter
+// store. It is safe for concurrent use by the Terraform SDK's test driver.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	filters   map[string]*Filter
+	nextID    int
+	failNext  int
+	stateFunc func(filterID, managedDeviceID string) string
+}
+
+// NewServer starts a mock Graph server and returns it. Callers should
+// configure the provider under test's `graph_beta_endpoint_override`
+// attribute with Server.URL and call Close (via the embedded
+// httptest.Server) when done.
+func NewServer() *Server {
+	s := &Server{filters: make(map[string]*Filter)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// FailNext causes the next n requests (of any kind) to be rejected with a
+// 429 response, for exercising the provider's retry transport.
+func (s *Server) FailNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failNext = n
+}
+
+// MutateRule simulates server-side drift by changing a stored filter's rule
+// out from under the provider, without going through the normal PATCH path.
+func (s *Server) MutateRule(id, rule string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f, ok := s.filters[id]; ok {
+		f.Rule = rule
+	}
+}
+
+// SetStateFunc overrides how `getState` evaluates a device against a filter.
+// By default every device is reported as "included".
+func (s *Server) SetStateFunc(fn func(filterID, managedDeviceID string) string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stateFunc = fn
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	if s.failNext > 0 {
+		s.failNext--
+		s.mu.Unlock()
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error":{"code":"TooManyRequests","message":"mockgraph: injected throttling"}}`))
+		return
+	}
+	s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/deviceManagement/assignmentFilters")
+
+	switch {
+	case path == "" && r.Method == http.MethodPost:
+		s.create(w, r)
+	case strings.HasSuffix(path, "/getState") && r.Method == http.MethodGet:
+		s.getState(w, r, strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/getState"))
+	case strings.HasPrefix(path, "/") && r.Method == http.MethodGet:
+		s.get(w, r, strings.TrimPrefix(path, "/"))
+	case strings.HasPrefix(path, "/") && r.Method == http.MethodPatch:
+		s.update(w, r, strings.TrimPrefix(path, "/"))
+	case strings.HasPrefix(path, "/") && r.Method == http.MethodDelete:
+		s.delete(w, r, strings.TrimPrefix(path, "/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request) {
+	var f Filter
+	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	f.ID = fmt.Sprintf("%d", s.nextID)
+	f.CreatedDateTime = "2024-01-01T00:00:00Z"
+	f.LastModifiedDateTime = f.CreatedDateTime
+	s.filters[f.ID] = &f
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, &f)
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	f, ok := s.filters[id]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("assignment filter %s not found", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, f)
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	f, ok := s.filters[id]
+	if !ok {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("assignment filter %s not found", id))
+		return
+	}
+	s.mu.Unlock()
+
+	var patch Filter
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	id2 := f.ID
+	created := f.CreatedDateTime
+	patch.ID = id2
+	patch.CreatedDateTime = created
+	patch.LastModifiedDateTime = "2024-01-02T00:00:00Z"
+	s.filters[id] = &patch
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	_, ok := s.filters[id]
+	delete(s.filters, id)
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("assignment filter %s not found", id))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getState(w http.ResponseWriter, r *http.Request, id string) {
+	managedDeviceID := r.URL.Query().Get("managedDeviceId")
+
+	s.mu.Lock()
+	_, ok := s.filters[id]
+	fn := s.stateFunc
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("assignment filter %s not found", id))
+		return
+	}
+
+	state := "included"
+	if fn != nil {
+		state = fn(id, managedDeviceID)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"state": state})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]string{"code": code, "message": message},
+	})
+}
@@ -0,0 +1,42 @@
+// Package testprovider wires the Microsoft365 provider to a mockgraph server
+// for hermetic resource.Test-based acceptance tests, so resource tests don't
+// need TF_ACC set against a real Azure tenant.
+package testprovider
+
+import (
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/provider"
+	"github.com/deploymenttheory/terraform-provider-microsoft365/internal/testing/mockgraph"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ProviderTypeName is the provider type as it must appear in acceptance
+// test configuration, e.g. `provider "microsoft365" {}`.
+const ProviderTypeName = "microsoft365"
+
+// ProtoV6ProviderFactories returns the ProtoV6ProviderFactories value
+// expected by resource.Test, wired to mock's URL via the
+// `graph_endpoint_override`/`graph_beta_endpoint_override` provider
+// attributes. Test configs must set both to mock.URL.
+func ProtoV6ProviderFactories(mock *mockgraph.Server) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		ProviderTypeName: providerserver.NewProtocol6WithError(provider.New("test")()),
+	}
+}
+
+// ConfigHeader returns the `provider "microsoft365" {}` block acceptance
+// tests should prepend to their Terraform configuration, pointing both the
+// stable and beta Graph clients at the mock server and using a static
+// client secret credential so no real authentication round trip occurs.
+func ConfigHeader(mock *mockgraph.Server) string {
+	return `
+provider "microsoft365" {
+  tenant_id                   = "00000000-0000-0000-0000-000000000000"
+  client_id                   = "00000000-0000-0000-0000-000000000000"
+  client_secret               = "mock-secret"
+  auth_method                 = "client_secret"
+  graph_endpoint_override     = "` + mock.URL + `"
+  graph_beta_endpoint_override = "` + mock.URL + `"
+}
+`
+}